@@ -2,7 +2,9 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
 	"errors"
+	"expvar"
 	"log/slog"
 	"net/http"
 	"os"
@@ -12,11 +14,14 @@ import (
 	"time"
 
 	_ "github.com/SokratisChaimanas/platform-go-challenge/docs"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/adapters/broker"
 	"github.com/SokratisChaimanas/platform-go-challenge/internal/adapters/ent" // ent adapters
 	chihttp "github.com/SokratisChaimanas/platform-go-challenge/internal/adapters/http/chi"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/adapters/webhook"
 	"github.com/SokratisChaimanas/platform-go-challenge/internal/app"
 	"github.com/SokratisChaimanas/platform-go-challenge/internal/platform/config"
 	"github.com/SokratisChaimanas/platform-go-challenge/internal/platform/db"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/ports"
 	"github.com/SokratisChaimanas/platform-go-challenge/internal/shared/logger"
 )
 
@@ -49,18 +54,85 @@ func main() {
 	}()
 	log.Info("database client initialized")
 
+	// Asset and favourite changes are recorded in the audit log from here on (dev seeding is not).
+	entadapter.UseAuditLog(entClient)
+
+	// Pagination cursors are signed; without a configured secret they only survive this process.
+	cursorKey := []byte(cfg.CursorSecret)
+	if len(cursorKey) == 0 {
+		cursorKey = make([]byte, 32)
+		if _, err := rand.Read(cursorKey); err != nil {
+			log.Error("failed to generate cursor key", "err", err)
+			os.Exit(1)
+		}
+		log.Warn("CURSOR_SECRET not set, using a random key; cursors are invalidated on restart")
+	}
+	cursors := entadapter.NewCursorCodec(cursorKey, cfg.CursorTTL)
+	syncTokens := entadapter.NewCursorCodec(cursorKey, cfg.SyncRetention)
+
 	// Wire adapters (repos)
 	userRepo := entadapter.NewUserRepo(entClient)
-	assetRepo := entadapter.NewAssetRepo(entClient)
-	favRepo := entadapter.NewFavouriteRepo(entClient)
+	assetRepo := entadapter.NewAssetRepo(entClient, cursors)
+	favRepo := entadapter.NewFavouriteRepo(entClient, cursors, syncTokens)
+	revisionRepo := entadapter.NewAssetRevisionRepo(entClient, cursors)
+	collectionRepo := entadapter.NewCollectionRepo(entClient)
+	webhookRepo := entadapter.NewWebhookRepo(entClient, cursors)
+	outboxRepo := entadapter.NewOutboxRepo(entClient)
+	auditRepo := entadapter.NewAuditRepo(entClient, cursors)
+	uow := entadapter.NewUnitOfWork(entClient)
+
+	// Live favourite events; the last 1000 are kept for clients resuming a stream.
+	events := broker.NewBroker(1000)
+
+	// Webhook receivers get WEBHOOK_TIMEOUT to answer; it has to stay below the delivery lease (1m).
+	// The client refuses to connect to internal addresses.
+	webhookSender := webhook.NewHTTPSender(webhook.NewHTTPClient(cfg.WebhookTimeout))
 
 	// Wire services (use cases)
 	userSvc := app.NewUserService(userRepo)
-	assetSvc := app.NewAssetService(assetRepo)
-	favSvc := app.NewFavouritesService(userRepo, assetRepo, favRepo)
+	webhookSvc := app.NewWebhookService(webhookRepo, webhookSender, cfg.WebhookBackoff)
+	assetSvc := app.NewAssetService(uow, assetRepo, revisionRepo, outboxRepo)
+	favSvc := app.NewFavouritesService(uow, userRepo, assetRepo, favRepo, collectionRepo, outboxRepo, events)
+	collectionSvc := app.NewCollectionService(userRepo, favRepo, collectionRepo)
+	recSvc := app.NewRecommendationService(userRepo, assetRepo, favRepo)
+	auditSvc := app.NewAuditService(auditRepo)
+
+	// Committed domain events are relayed from the outbox to the log, the live streams and webhooks.
+	relay := app.NewOutboxRelay(outboxRepo, []ports.OutboxPublisher{
+		app.NewLogPublisher(log),
+		app.NewLivePublisher(events),
+		webhookSvc,
+	}, cfg.OutboxBackoff)
+	expvar.Publish("outbox", relay.Metrics())
+
+	// Background jobs stop with this context on shutdown.
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	go recSvc.Run(jobsCtx, log, cfg.RecommenderRefresh, cfg.RecommenderRebuild)
+	go relay.Run(jobsCtx, log, cfg.OutboxPoll)
+	go webhookSvc.Run(jobsCtx, log, cfg.WebhookPoll)
+	go runEvery(jobsCtx, time.Hour, func(ctx context.Context) {
+		n, err := favSvc.PruneTombstones(ctx, cfg.SyncRetention)
+		if err != nil {
+			log.Error("pruning favourite tombstones failed", "err", err)
+			return
+		}
+		log.Debug("pruned favourite tombstones", "count", n)
+	})
+	go runEvery(jobsCtx, time.Hour, func(ctx context.Context) {
+		n, err := relay.Prune(ctx, cfg.OutboxRetention)
+		if err != nil {
+			log.Error("pruning outbox events failed", "err", err)
+			return
+		}
+		log.Debug("pruned outbox events", "count", n)
+	})
 
 	// Build HTTP router
-	router := chihttp.NewRouter(userSvc, assetSvc, favSvc)
+	if cfg.AdminToken == "" {
+		log.Warn("ADMIN_TOKEN not set, admin endpoints are disabled")
+	}
+	router := chihttp.NewRouter(userSvc, assetSvc, favSvc, collectionSvc, recSvc, webhookSvc, auditSvc, cfg.AdminToken)
 
 	// HTTP server
 	srv := &http.Server{
@@ -71,6 +143,8 @@ func main() {
 		WriteTimeout:      15 * time.Second,
 		IdleTimeout:       60 * time.Second,
 	}
+	// Shutdown waits for connections to go idle; ending the event streams lets it finish.
+	srv.RegisterOnShutdown(events.Close)
 
 	// Run server in background
 	go func() {
@@ -86,6 +160,7 @@ func main() {
 
 	sig := <-quit
 	log.Info("shutdown signal received", "signal", sig.String())
+	stopJobs()
 
 	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer shutdownCancel()
@@ -98,6 +173,21 @@ func main() {
 	}
 }
 
+// runEvery calls fn right away and then every interval until ctx is cancelled.
+func runEvery(ctx context.Context, interval time.Duration, fn func(ctx context.Context)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		fn(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 // parseLevel converts a string like "debug", "info", "warn", "error" to slog.Level.
 func parseLevel(s string) slog.Level {
 	switch strings.ToLower(strings.TrimSpace(s)) {
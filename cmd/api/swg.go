@@ -11,4 +11,9 @@
 // @accept          json
 // @produce         json
 // @BasePath        /api
+//
+// @securityDefinitions.apikey  AdminToken
+// @in                          header
+// @name                        Authorization
+// @description                 "Bearer <ADMIN_TOKEN>", required by admin endpoints.
 package main
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/SokratisChaimanas/platform-go-challenge/ent/asset"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/assetrevision"
 	"github.com/SokratisChaimanas/platform-go-challenge/ent/favourite"
 	"github.com/google/uuid"
 )
@@ -40,6 +41,20 @@ func (_c *AssetCreate) SetPayload(v map[string]interface{}) *AssetCreate {
 	return _c
 }
 
+// SetVersion sets the "version" field.
+func (_c *AssetCreate) SetVersion(v int) *AssetCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *AssetCreate) SetNillableVersion(v *int) *AssetCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *AssetCreate) SetCreatedAt(v time.Time) *AssetCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -54,6 +69,20 @@ func (_c *AssetCreate) SetNillableCreatedAt(v *time.Time) *AssetCreate {
 	return _c
 }
 
+// SetArchivedAt sets the "archived_at" field.
+func (_c *AssetCreate) SetArchivedAt(v time.Time) *AssetCreate {
+	_c.mutation.SetArchivedAt(v)
+	return _c
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_c *AssetCreate) SetNillableArchivedAt(v *time.Time) *AssetCreate {
+	if v != nil {
+		_c.SetArchivedAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *AssetCreate) SetID(v uuid.UUID) *AssetCreate {
 	_c.mutation.SetID(v)
@@ -83,6 +112,21 @@ func (_c *AssetCreate) AddFavourites(v ...*Favourite) *AssetCreate {
 	return _c.AddFavouriteIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the AssetRevision entity by IDs.
+func (_c *AssetCreate) AddRevisionIDs(ids ...uuid.UUID) *AssetCreate {
+	_c.mutation.AddRevisionIDs(ids...)
+	return _c
+}
+
+// AddRevisions adds the "revisions" edges to the AssetRevision entity.
+func (_c *AssetCreate) AddRevisions(v ...*AssetRevision) *AssetCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRevisionIDs(ids...)
+}
+
 // Mutation returns the AssetMutation object of the builder.
 func (_c *AssetCreate) Mutation() *AssetMutation {
 	return _c.mutation
@@ -118,6 +162,10 @@ func (_c *AssetCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *AssetCreate) defaults() {
+	if _, ok := _c.mutation.Version(); !ok {
+		v := asset.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := asset.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -149,6 +197,14 @@ func (_c *AssetCreate) check() error {
 	if _, ok := _c.mutation.Payload(); !ok {
 		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "Asset.payload"`)}
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Asset.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := asset.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Asset.version": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Asset.created_at"`)}
 	}
@@ -199,10 +255,18 @@ func (_c *AssetCreate) createSpec() (*Asset, *sqlgraph.CreateSpec) {
 		_spec.SetField(asset.FieldPayload, field.TypeJSON, value)
 		_node.Payload = value
 	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(asset.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(asset.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := _c.mutation.ArchivedAt(); ok {
+		_spec.SetField(asset.FieldArchivedAt, field.TypeTime, value)
+		_node.ArchivedAt = &value
+	}
 	if nodes := _c.mutation.FavouritesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -219,6 +283,22 @@ func (_c *AssetCreate) createSpec() (*Asset, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   asset.RevisionsTable,
+			Columns: []string{asset.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assetrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
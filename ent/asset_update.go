@@ -6,11 +6,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/SokratisChaimanas/platform-go-challenge/ent/asset"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/assetrevision"
 	"github.com/SokratisChaimanas/platform-go-challenge/ent/favourite"
 	"github.com/SokratisChaimanas/platform-go-challenge/ent/predicate"
 	"github.com/google/uuid"
@@ -49,6 +51,47 @@ func (_u *AssetUpdate) SetPayload(v map[string]interface{}) *AssetUpdate {
 	return _u
 }
 
+// SetVersion sets the "version" field.
+func (_u *AssetUpdate) SetVersion(v int) *AssetUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *AssetUpdate) SetNillableVersion(v *int) *AssetUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *AssetUpdate) AddVersion(v int) *AssetUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (_u *AssetUpdate) SetArchivedAt(v time.Time) *AssetUpdate {
+	_u.mutation.SetArchivedAt(v)
+	return _u
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_u *AssetUpdate) SetNillableArchivedAt(v *time.Time) *AssetUpdate {
+	if v != nil {
+		_u.SetArchivedAt(*v)
+	}
+	return _u
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (_u *AssetUpdate) ClearArchivedAt() *AssetUpdate {
+	_u.mutation.ClearArchivedAt()
+	return _u
+}
+
 // AddFavouriteIDs adds the "favourites" edge to the Favourite entity by IDs.
 func (_u *AssetUpdate) AddFavouriteIDs(ids ...uuid.UUID) *AssetUpdate {
 	_u.mutation.AddFavouriteIDs(ids...)
@@ -64,6 +107,21 @@ func (_u *AssetUpdate) AddFavourites(v ...*Favourite) *AssetUpdate {
 	return _u.AddFavouriteIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the AssetRevision entity by IDs.
+func (_u *AssetUpdate) AddRevisionIDs(ids ...uuid.UUID) *AssetUpdate {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the AssetRevision entity.
+func (_u *AssetUpdate) AddRevisions(v ...*AssetRevision) *AssetUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
 // Mutation returns the AssetMutation object of the builder.
 func (_u *AssetUpdate) Mutation() *AssetMutation {
 	return _u.mutation
@@ -90,6 +148,27 @@ func (_u *AssetUpdate) RemoveFavourites(v ...*Favourite) *AssetUpdate {
 	return _u.RemoveFavouriteIDs(ids...)
 }
 
+// ClearRevisions clears all "revisions" edges to the AssetRevision entity.
+func (_u *AssetUpdate) ClearRevisions() *AssetUpdate {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to AssetRevision entities by IDs.
+func (_u *AssetUpdate) RemoveRevisionIDs(ids ...uuid.UUID) *AssetUpdate {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to AssetRevision entities.
+func (_u *AssetUpdate) RemoveRevisions(v ...*AssetRevision) *AssetUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *AssetUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -124,6 +203,11 @@ func (_u *AssetUpdate) check() error {
 			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Asset.description": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := asset.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Asset.version": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -145,6 +229,18 @@ func (_u *AssetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Payload(); ok {
 		_spec.SetField(asset.FieldPayload, field.TypeJSON, value)
 	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(asset.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(asset.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ArchivedAt(); ok {
+		_spec.SetField(asset.FieldArchivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ArchivedAtCleared() {
+		_spec.ClearField(asset.FieldArchivedAt, field.TypeTime)
+	}
 	if _u.mutation.FavouritesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -190,6 +286,51 @@ func (_u *AssetUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   asset.RevisionsTable,
+			Columns: []string{asset.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assetrevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   asset.RevisionsTable,
+			Columns: []string{asset.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assetrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   asset.RevisionsTable,
+			Columns: []string{asset.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assetrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{asset.Label}
@@ -230,6 +371,47 @@ func (_u *AssetUpdateOne) SetPayload(v map[string]interface{}) *AssetUpdateOne {
 	return _u
 }
 
+// SetVersion sets the "version" field.
+func (_u *AssetUpdateOne) SetVersion(v int) *AssetUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *AssetUpdateOne) SetNillableVersion(v *int) *AssetUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *AssetUpdateOne) AddVersion(v int) *AssetUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetArchivedAt sets the "archived_at" field.
+func (_u *AssetUpdateOne) SetArchivedAt(v time.Time) *AssetUpdateOne {
+	_u.mutation.SetArchivedAt(v)
+	return _u
+}
+
+// SetNillableArchivedAt sets the "archived_at" field if the given value is not nil.
+func (_u *AssetUpdateOne) SetNillableArchivedAt(v *time.Time) *AssetUpdateOne {
+	if v != nil {
+		_u.SetArchivedAt(*v)
+	}
+	return _u
+}
+
+// ClearArchivedAt clears the value of the "archived_at" field.
+func (_u *AssetUpdateOne) ClearArchivedAt() *AssetUpdateOne {
+	_u.mutation.ClearArchivedAt()
+	return _u
+}
+
 // AddFavouriteIDs adds the "favourites" edge to the Favourite entity by IDs.
 func (_u *AssetUpdateOne) AddFavouriteIDs(ids ...uuid.UUID) *AssetUpdateOne {
 	_u.mutation.AddFavouriteIDs(ids...)
@@ -245,6 +427,21 @@ func (_u *AssetUpdateOne) AddFavourites(v ...*Favourite) *AssetUpdateOne {
 	return _u.AddFavouriteIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the AssetRevision entity by IDs.
+func (_u *AssetUpdateOne) AddRevisionIDs(ids ...uuid.UUID) *AssetUpdateOne {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the AssetRevision entity.
+func (_u *AssetUpdateOne) AddRevisions(v ...*AssetRevision) *AssetUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
 // Mutation returns the AssetMutation object of the builder.
 func (_u *AssetUpdateOne) Mutation() *AssetMutation {
 	return _u.mutation
@@ -271,6 +468,27 @@ func (_u *AssetUpdateOne) RemoveFavourites(v ...*Favourite) *AssetUpdateOne {
 	return _u.RemoveFavouriteIDs(ids...)
 }
 
+// ClearRevisions clears all "revisions" edges to the AssetRevision entity.
+func (_u *AssetUpdateOne) ClearRevisions() *AssetUpdateOne {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to AssetRevision entities by IDs.
+func (_u *AssetUpdateOne) RemoveRevisionIDs(ids ...uuid.UUID) *AssetUpdateOne {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to AssetRevision entities.
+func (_u *AssetUpdateOne) RemoveRevisions(v ...*AssetRevision) *AssetUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
 // Where appends a list predicates to the AssetUpdate builder.
 func (_u *AssetUpdateOne) Where(ps ...predicate.Asset) *AssetUpdateOne {
 	_u.mutation.Where(ps...)
@@ -318,6 +536,11 @@ func (_u *AssetUpdateOne) check() error {
 			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "Asset.description": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := asset.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Asset.version": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -356,6 +579,18 @@ func (_u *AssetUpdateOne) sqlSave(ctx context.Context) (_node *Asset, err error)
 	if value, ok := _u.mutation.Payload(); ok {
 		_spec.SetField(asset.FieldPayload, field.TypeJSON, value)
 	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(asset.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(asset.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ArchivedAt(); ok {
+		_spec.SetField(asset.FieldArchivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ArchivedAtCleared() {
+		_spec.ClearField(asset.FieldArchivedAt, field.TypeTime)
+	}
 	if _u.mutation.FavouritesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -401,6 +636,51 @@ func (_u *AssetUpdateOne) sqlSave(ctx context.Context) (_node *Asset, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   asset.RevisionsTable,
+			Columns: []string{asset.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assetrevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   asset.RevisionsTable,
+			Columns: []string{asset.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assetrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   asset.RevisionsTable,
+			Columns: []string{asset.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assetrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Asset{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/asset"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/assetrevision"
+	"github.com/google/uuid"
+)
+
+// AssetRevision is the model entity for the AssetRevision schema.
+type AssetRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// AssetID holds the value of the "asset_id" field.
+	AssetID uuid.UUID `json:"asset_id,omitempty"`
+	// OldDescription holds the value of the "old_description" field.
+	OldDescription string `json:"old_description,omitempty"`
+	// NewDescription holds the value of the "new_description" field.
+	NewDescription string `json:"new_description,omitempty"`
+	// EditorID holds the value of the "editor_id" field.
+	EditorID *uuid.UUID `json:"editor_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AssetRevisionQuery when eager-loading is set.
+	Edges        AssetRevisionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AssetRevisionEdges holds the relations/edges for other nodes in the graph.
+type AssetRevisionEdges struct {
+	// Asset holds the value of the asset edge.
+	Asset *Asset `json:"asset,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AssetOrErr returns the Asset value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AssetRevisionEdges) AssetOrErr() (*Asset, error) {
+	if e.Asset != nil {
+		return e.Asset, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: asset.Label}
+	}
+	return nil, &NotLoadedError{edge: "asset"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AssetRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case assetrevision.FieldEditorID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case assetrevision.FieldOldDescription, assetrevision.FieldNewDescription:
+			values[i] = new(sql.NullString)
+		case assetrevision.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case assetrevision.FieldID, assetrevision.FieldAssetID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AssetRevision fields.
+func (_m *AssetRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case assetrevision.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case assetrevision.FieldAssetID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field asset_id", values[i])
+			} else if value != nil {
+				_m.AssetID = *value
+			}
+		case assetrevision.FieldOldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field old_description", values[i])
+			} else if value.Valid {
+				_m.OldDescription = value.String
+			}
+		case assetrevision.FieldNewDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field new_description", values[i])
+			} else if value.Valid {
+				_m.NewDescription = value.String
+			}
+		case assetrevision.FieldEditorID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field editor_id", values[i])
+			} else if value.Valid {
+				_m.EditorID = new(uuid.UUID)
+				*_m.EditorID = *value.S.(*uuid.UUID)
+			}
+		case assetrevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AssetRevision.
+// This includes values selected through modifiers, order, etc.
+func (_m *AssetRevision) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAsset queries the "asset" edge of the AssetRevision entity.
+func (_m *AssetRevision) QueryAsset() *AssetQuery {
+	return NewAssetRevisionClient(_m.config).QueryAsset(_m)
+}
+
+// Update returns a builder for updating this AssetRevision.
+// Note that you need to call AssetRevision.Unwrap() before calling this method if this AssetRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AssetRevision) Update() *AssetRevisionUpdateOne {
+	return NewAssetRevisionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AssetRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AssetRevision) Unwrap() *AssetRevision {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AssetRevision is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AssetRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("AssetRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("asset_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AssetID))
+	builder.WriteString(", ")
+	builder.WriteString("old_description=")
+	builder.WriteString(_m.OldDescription)
+	builder.WriteString(", ")
+	builder.WriteString("new_description=")
+	builder.WriteString(_m.NewDescription)
+	builder.WriteString(", ")
+	if v := _m.EditorID; v != nil {
+		builder.WriteString("editor_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AssetRevisions is a parsable slice of AssetRevision.
+type AssetRevisions []*AssetRevision
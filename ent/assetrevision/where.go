@@ -0,0 +1,360 @@
+// Code generated by ent, DO NOT EDIT.
+
+package assetrevision
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldLTE(FieldID, id))
+}
+
+// AssetID applies equality check predicate on the "asset_id" field. It's identical to AssetIDEQ.
+func AssetID(v uuid.UUID) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldEQ(FieldAssetID, v))
+}
+
+// OldDescription applies equality check predicate on the "old_description" field. It's identical to OldDescriptionEQ.
+func OldDescription(v string) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldEQ(FieldOldDescription, v))
+}
+
+// NewDescription applies equality check predicate on the "new_description" field. It's identical to NewDescriptionEQ.
+func NewDescription(v string) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldEQ(FieldNewDescription, v))
+}
+
+// EditorID applies equality check predicate on the "editor_id" field. It's identical to EditorIDEQ.
+func EditorID(v uuid.UUID) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldEQ(FieldEditorID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AssetIDEQ applies the EQ predicate on the "asset_id" field.
+func AssetIDEQ(v uuid.UUID) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldEQ(FieldAssetID, v))
+}
+
+// AssetIDNEQ applies the NEQ predicate on the "asset_id" field.
+func AssetIDNEQ(v uuid.UUID) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldNEQ(FieldAssetID, v))
+}
+
+// AssetIDIn applies the In predicate on the "asset_id" field.
+func AssetIDIn(vs ...uuid.UUID) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldIn(FieldAssetID, vs...))
+}
+
+// AssetIDNotIn applies the NotIn predicate on the "asset_id" field.
+func AssetIDNotIn(vs ...uuid.UUID) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldNotIn(FieldAssetID, vs...))
+}
+
+// OldDescriptionEQ applies the EQ predicate on the "old_description" field.
+func OldDescriptionEQ(v string) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldEQ(FieldOldDescription, v))
+}
+
+// OldDescriptionNEQ applies the NEQ predicate on the "old_description" field.
+func OldDescriptionNEQ(v string) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldNEQ(FieldOldDescription, v))
+}
+
+// OldDescriptionIn applies the In predicate on the "old_description" field.
+func OldDescriptionIn(vs ...string) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldIn(FieldOldDescription, vs...))
+}
+
+// OldDescriptionNotIn applies the NotIn predicate on the "old_description" field.
+func OldDescriptionNotIn(vs ...string) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldNotIn(FieldOldDescription, vs...))
+}
+
+// OldDescriptionGT applies the GT predicate on the "old_description" field.
+func OldDescriptionGT(v string) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldGT(FieldOldDescription, v))
+}
+
+// OldDescriptionGTE applies the GTE predicate on the "old_description" field.
+func OldDescriptionGTE(v string) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldGTE(FieldOldDescription, v))
+}
+
+// OldDescriptionLT applies the LT predicate on the "old_description" field.
+func OldDescriptionLT(v string) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldLT(FieldOldDescription, v))
+}
+
+// OldDescriptionLTE applies the LTE predicate on the "old_description" field.
+func OldDescriptionLTE(v string) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldLTE(FieldOldDescription, v))
+}
+
+// OldDescriptionContains applies the Contains predicate on the "old_description" field.
+func OldDescriptionContains(v string) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldContains(FieldOldDescription, v))
+}
+
+// OldDescriptionHasPrefix applies the HasPrefix predicate on the "old_description" field.
+func OldDescriptionHasPrefix(v string) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldHasPrefix(FieldOldDescription, v))
+}
+
+// OldDescriptionHasSuffix applies the HasSuffix predicate on the "old_description" field.
+func OldDescriptionHasSuffix(v string) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldHasSuffix(FieldOldDescription, v))
+}
+
+// OldDescriptionEqualFold applies the EqualFold predicate on the "old_description" field.
+func OldDescriptionEqualFold(v string) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldEqualFold(FieldOldDescription, v))
+}
+
+// OldDescriptionContainsFold applies the ContainsFold predicate on the "old_description" field.
+func OldDescriptionContainsFold(v string) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldContainsFold(FieldOldDescription, v))
+}
+
+// NewDescriptionEQ applies the EQ predicate on the "new_description" field.
+func NewDescriptionEQ(v string) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldEQ(FieldNewDescription, v))
+}
+
+// NewDescriptionNEQ applies the NEQ predicate on the "new_description" field.
+func NewDescriptionNEQ(v string) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldNEQ(FieldNewDescription, v))
+}
+
+// NewDescriptionIn applies the In predicate on the "new_description" field.
+func NewDescriptionIn(vs ...string) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldIn(FieldNewDescription, vs...))
+}
+
+// NewDescriptionNotIn applies the NotIn predicate on the "new_description" field.
+func NewDescriptionNotIn(vs ...string) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldNotIn(FieldNewDescription, vs...))
+}
+
+// NewDescriptionGT applies the GT predicate on the "new_description" field.
+func NewDescriptionGT(v string) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldGT(FieldNewDescription, v))
+}
+
+// NewDescriptionGTE applies the GTE predicate on the "new_description" field.
+func NewDescriptionGTE(v string) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldGTE(FieldNewDescription, v))
+}
+
+// NewDescriptionLT applies the LT predicate on the "new_description" field.
+func NewDescriptionLT(v string) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldLT(FieldNewDescription, v))
+}
+
+// NewDescriptionLTE applies the LTE predicate on the "new_description" field.
+func NewDescriptionLTE(v string) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldLTE(FieldNewDescription, v))
+}
+
+// NewDescriptionContains applies the Contains predicate on the "new_description" field.
+func NewDescriptionContains(v string) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldContains(FieldNewDescription, v))
+}
+
+// NewDescriptionHasPrefix applies the HasPrefix predicate on the "new_description" field.
+func NewDescriptionHasPrefix(v string) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldHasPrefix(FieldNewDescription, v))
+}
+
+// NewDescriptionHasSuffix applies the HasSuffix predicate on the "new_description" field.
+func NewDescriptionHasSuffix(v string) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldHasSuffix(FieldNewDescription, v))
+}
+
+// NewDescriptionEqualFold applies the EqualFold predicate on the "new_description" field.
+func NewDescriptionEqualFold(v string) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldEqualFold(FieldNewDescription, v))
+}
+
+// NewDescriptionContainsFold applies the ContainsFold predicate on the "new_description" field.
+func NewDescriptionContainsFold(v string) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldContainsFold(FieldNewDescription, v))
+}
+
+// EditorIDEQ applies the EQ predicate on the "editor_id" field.
+func EditorIDEQ(v uuid.UUID) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldEQ(FieldEditorID, v))
+}
+
+// EditorIDNEQ applies the NEQ predicate on the "editor_id" field.
+func EditorIDNEQ(v uuid.UUID) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldNEQ(FieldEditorID, v))
+}
+
+// EditorIDIn applies the In predicate on the "editor_id" field.
+func EditorIDIn(vs ...uuid.UUID) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldIn(FieldEditorID, vs...))
+}
+
+// EditorIDNotIn applies the NotIn predicate on the "editor_id" field.
+func EditorIDNotIn(vs ...uuid.UUID) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldNotIn(FieldEditorID, vs...))
+}
+
+// EditorIDGT applies the GT predicate on the "editor_id" field.
+func EditorIDGT(v uuid.UUID) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldGT(FieldEditorID, v))
+}
+
+// EditorIDGTE applies the GTE predicate on the "editor_id" field.
+func EditorIDGTE(v uuid.UUID) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldGTE(FieldEditorID, v))
+}
+
+// EditorIDLT applies the LT predicate on the "editor_id" field.
+func EditorIDLT(v uuid.UUID) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldLT(FieldEditorID, v))
+}
+
+// EditorIDLTE applies the LTE predicate on the "editor_id" field.
+func EditorIDLTE(v uuid.UUID) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldLTE(FieldEditorID, v))
+}
+
+// EditorIDIsNil applies the IsNil predicate on the "editor_id" field.
+func EditorIDIsNil() predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldIsNull(FieldEditorID))
+}
+
+// EditorIDNotNil applies the NotNil predicate on the "editor_id" field.
+func EditorIDNotNil() predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldNotNull(FieldEditorID))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasAsset applies the HasEdge predicate on the "asset" edge.
+func HasAsset() predicate.AssetRevision {
+	return predicate.AssetRevision(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AssetTable, AssetColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAssetWith applies the HasEdge predicate on the "asset" edge with a given conditions (other predicates).
+func HasAssetWith(preds ...predicate.Asset) predicate.AssetRevision {
+	return predicate.AssetRevision(func(s *sql.Selector) {
+		step := newAssetStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AssetRevision) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AssetRevision) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AssetRevision) predicate.AssetRevision {
+	return predicate.AssetRevision(sql.NotPredicates(p))
+}
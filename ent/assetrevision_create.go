@@ -0,0 +1,305 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/asset"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/assetrevision"
+	"github.com/google/uuid"
+)
+
+// AssetRevisionCreate is the builder for creating a AssetRevision entity.
+type AssetRevisionCreate struct {
+	config
+	mutation *AssetRevisionMutation
+	hooks    []Hook
+}
+
+// SetAssetID sets the "asset_id" field.
+func (_c *AssetRevisionCreate) SetAssetID(v uuid.UUID) *AssetRevisionCreate {
+	_c.mutation.SetAssetID(v)
+	return _c
+}
+
+// SetOldDescription sets the "old_description" field.
+func (_c *AssetRevisionCreate) SetOldDescription(v string) *AssetRevisionCreate {
+	_c.mutation.SetOldDescription(v)
+	return _c
+}
+
+// SetNewDescription sets the "new_description" field.
+func (_c *AssetRevisionCreate) SetNewDescription(v string) *AssetRevisionCreate {
+	_c.mutation.SetNewDescription(v)
+	return _c
+}
+
+// SetEditorID sets the "editor_id" field.
+func (_c *AssetRevisionCreate) SetEditorID(v uuid.UUID) *AssetRevisionCreate {
+	_c.mutation.SetEditorID(v)
+	return _c
+}
+
+// SetNillableEditorID sets the "editor_id" field if the given value is not nil.
+func (_c *AssetRevisionCreate) SetNillableEditorID(v *uuid.UUID) *AssetRevisionCreate {
+	if v != nil {
+		_c.SetEditorID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AssetRevisionCreate) SetCreatedAt(v time.Time) *AssetRevisionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AssetRevisionCreate) SetNillableCreatedAt(v *time.Time) *AssetRevisionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AssetRevisionCreate) SetID(v uuid.UUID) *AssetRevisionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AssetRevisionCreate) SetNillableID(v *uuid.UUID) *AssetRevisionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAsset sets the "asset" edge to the Asset entity.
+func (_c *AssetRevisionCreate) SetAsset(v *Asset) *AssetRevisionCreate {
+	return _c.SetAssetID(v.ID)
+}
+
+// Mutation returns the AssetRevisionMutation object of the builder.
+func (_c *AssetRevisionCreate) Mutation() *AssetRevisionMutation {
+	return _c.mutation
+}
+
+// Save creates the AssetRevision in the database.
+func (_c *AssetRevisionCreate) Save(ctx context.Context) (*AssetRevision, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AssetRevisionCreate) SaveX(ctx context.Context) *AssetRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AssetRevisionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AssetRevisionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AssetRevisionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := assetrevision.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := assetrevision.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AssetRevisionCreate) check() error {
+	if _, ok := _c.mutation.AssetID(); !ok {
+		return &ValidationError{Name: "asset_id", err: errors.New(`ent: missing required field "AssetRevision.asset_id"`)}
+	}
+	if _, ok := _c.mutation.OldDescription(); !ok {
+		return &ValidationError{Name: "old_description", err: errors.New(`ent: missing required field "AssetRevision.old_description"`)}
+	}
+	if _, ok := _c.mutation.NewDescription(); !ok {
+		return &ValidationError{Name: "new_description", err: errors.New(`ent: missing required field "AssetRevision.new_description"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AssetRevision.created_at"`)}
+	}
+	if len(_c.mutation.AssetIDs()) == 0 {
+		return &ValidationError{Name: "asset", err: errors.New(`ent: missing required edge "AssetRevision.asset"`)}
+	}
+	return nil
+}
+
+func (_c *AssetRevisionCreate) sqlSave(ctx context.Context) (*AssetRevision, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AssetRevisionCreate) createSpec() (*AssetRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AssetRevision{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(assetrevision.Table, sqlgraph.NewFieldSpec(assetrevision.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.OldDescription(); ok {
+		_spec.SetField(assetrevision.FieldOldDescription, field.TypeString, value)
+		_node.OldDescription = value
+	}
+	if value, ok := _c.mutation.NewDescription(); ok {
+		_spec.SetField(assetrevision.FieldNewDescription, field.TypeString, value)
+		_node.NewDescription = value
+	}
+	if value, ok := _c.mutation.EditorID(); ok {
+		_spec.SetField(assetrevision.FieldEditorID, field.TypeUUID, value)
+		_node.EditorID = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(assetrevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.AssetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   assetrevision.AssetTable,
+			Columns: []string{assetrevision.AssetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(asset.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AssetID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AssetRevisionCreateBulk is the builder for creating many AssetRevision entities in bulk.
+type AssetRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*AssetRevisionCreate
+}
+
+// Save creates the AssetRevision entities in the database.
+func (_c *AssetRevisionCreateBulk) Save(ctx context.Context) ([]*AssetRevision, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AssetRevision, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AssetRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AssetRevisionCreateBulk) SaveX(ctx context.Context) []*AssetRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AssetRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AssetRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/assetrevision"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/predicate"
+)
+
+// AssetRevisionUpdate is the builder for updating AssetRevision entities.
+type AssetRevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AssetRevisionMutation
+}
+
+// Where appends a list predicates to the AssetRevisionUpdate builder.
+func (_u *AssetRevisionUpdate) Where(ps ...predicate.AssetRevision) *AssetRevisionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the AssetRevisionMutation object of the builder.
+func (_u *AssetRevisionUpdate) Mutation() *AssetRevisionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AssetRevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AssetRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AssetRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AssetRevisionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AssetRevisionUpdate) check() error {
+	if _u.mutation.AssetCleared() && len(_u.mutation.AssetIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AssetRevision.asset"`)
+	}
+	return nil
+}
+
+func (_u *AssetRevisionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(assetrevision.Table, assetrevision.Columns, sqlgraph.NewFieldSpec(assetrevision.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.EditorIDCleared() {
+		_spec.ClearField(assetrevision.FieldEditorID, field.TypeUUID)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{assetrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AssetRevisionUpdateOne is the builder for updating a single AssetRevision entity.
+type AssetRevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AssetRevisionMutation
+}
+
+// Mutation returns the AssetRevisionMutation object of the builder.
+func (_u *AssetRevisionUpdateOne) Mutation() *AssetRevisionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AssetRevisionUpdate builder.
+func (_u *AssetRevisionUpdateOne) Where(ps ...predicate.AssetRevision) *AssetRevisionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AssetRevisionUpdateOne) Select(field string, fields ...string) *AssetRevisionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AssetRevision entity.
+func (_u *AssetRevisionUpdateOne) Save(ctx context.Context) (*AssetRevision, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AssetRevisionUpdateOne) SaveX(ctx context.Context) *AssetRevision {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AssetRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AssetRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AssetRevisionUpdateOne) check() error {
+	if _u.mutation.AssetCleared() && len(_u.mutation.AssetIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AssetRevision.asset"`)
+	}
+	return nil
+}
+
+func (_u *AssetRevisionUpdateOne) sqlSave(ctx context.Context) (_node *AssetRevision, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(assetrevision.Table, assetrevision.Columns, sqlgraph.NewFieldSpec(assetrevision.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AssetRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, assetrevision.FieldID)
+		for _, f := range fields {
+			if !assetrevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != assetrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.EditorIDCleared() {
+		_spec.ClearField(assetrevision.FieldEditorID, field.TypeUUID)
+	}
+	_node = &AssetRevision{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{assetrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -13,8 +13,15 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/SokratisChaimanas/platform-go-challenge/ent/asset"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/assetrevision"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/auditentry"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/collection"
 	"github.com/SokratisChaimanas/platform-go-challenge/ent/favourite"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/favouritetombstone"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/outboxevent"
 	"github.com/SokratisChaimanas/platform-go-challenge/ent/user"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/webhookdelivery"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/webhooksubscription"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -75,9 +82,16 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			asset.Table:     asset.ValidColumn,
-			favourite.Table: favourite.ValidColumn,
-			user.Table:      user.ValidColumn,
+			asset.Table:               asset.ValidColumn,
+			assetrevision.Table:       assetrevision.ValidColumn,
+			auditentry.Table:          auditentry.ValidColumn,
+			collection.Table:          collection.ValidColumn,
+			favourite.Table:           favourite.ValidColumn,
+			favouritetombstone.Table:  favouritetombstone.ValidColumn,
+			outboxevent.Table:         outboxevent.ValidColumn,
+			user.Table:                user.ValidColumn,
+			webhookdelivery.Table:     webhookdelivery.ValidColumn,
+			webhooksubscription.Table: webhooksubscription.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
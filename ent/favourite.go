@@ -24,8 +24,14 @@ type Favourite struct {
 	UserID uuid.UUID `json:"user_id,omitempty"`
 	// AssetID holds the value of the "asset_id" field.
 	AssetID uuid.UUID `json:"asset_id,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ChangedAt holds the value of the "changed_at" field.
+	ChangedAt time.Time `json:"changed_at,omitempty"`
+	// ChangedTx holds the value of the "changed_tx" field.
+	ChangedTx int64 `json:"changed_tx,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the FavouriteQuery when eager-loading is set.
 	Edges        FavouriteEdges `json:"edges"`
@@ -38,9 +44,11 @@ type FavouriteEdges struct {
 	User *User `json:"user,omitempty"`
 	// Asset holds the value of the asset edge.
 	Asset *Asset `json:"asset,omitempty"`
+	// Collections holds the value of the collections edge.
+	Collections []*Collection `json:"collections,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -65,12 +73,25 @@ func (e FavouriteEdges) AssetOrErr() (*Asset, error) {
 	return nil, &NotLoadedError{edge: "asset"}
 }
 
+// CollectionsOrErr returns the Collections value or an error if the edge
+// was not loaded in eager-loading.
+func (e FavouriteEdges) CollectionsOrErr() ([]*Collection, error) {
+	if e.loadedTypes[2] {
+		return e.Collections, nil
+	}
+	return nil, &NotLoadedError{edge: "collections"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Favourite) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case favourite.FieldCreatedAt:
+		case favourite.FieldChangedTx:
+			values[i] = new(sql.NullInt64)
+		case favourite.FieldNote:
+			values[i] = new(sql.NullString)
+		case favourite.FieldCreatedAt, favourite.FieldChangedAt:
 			values[i] = new(sql.NullTime)
 		case favourite.FieldID, favourite.FieldUserID, favourite.FieldAssetID:
 			values[i] = new(uuid.UUID)
@@ -107,12 +128,30 @@ func (_m *Favourite) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.AssetID = *value
 			}
+		case favourite.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
 		case favourite.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
 			} else if value.Valid {
 				_m.CreatedAt = value.Time
 			}
+		case favourite.FieldChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_at", values[i])
+			} else if value.Valid {
+				_m.ChangedAt = value.Time
+			}
+		case favourite.FieldChangedTx:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_tx", values[i])
+			} else if value.Valid {
+				_m.ChangedTx = value.Int64
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -136,6 +175,11 @@ func (_m *Favourite) QueryAsset() *AssetQuery {
 	return NewFavouriteClient(_m.config).QueryAsset(_m)
 }
 
+// QueryCollections queries the "collections" edge of the Favourite entity.
+func (_m *Favourite) QueryCollections() *CollectionQuery {
+	return NewFavouriteClient(_m.config).QueryCollections(_m)
+}
+
 // Update returns a builder for updating this Favourite.
 // Note that you need to call Favourite.Unwrap() before calling this method if this Favourite
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -165,8 +209,17 @@ func (_m *Favourite) String() string {
 	builder.WriteString("asset_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.AssetID))
 	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("changed_at=")
+	builder.WriteString(_m.ChangedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("changed_tx=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChangedTx))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -66,11 +66,26 @@ func AssetID(v uuid.UUID) predicate.Favourite {
 	return predicate.Favourite(sql.FieldEQ(FieldAssetID, v))
 }
 
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.Favourite {
+	return predicate.Favourite(sql.FieldEQ(FieldNote, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Favourite {
 	return predicate.Favourite(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// ChangedAt applies equality check predicate on the "changed_at" field. It's identical to ChangedAtEQ.
+func ChangedAt(v time.Time) predicate.Favourite {
+	return predicate.Favourite(sql.FieldEQ(FieldChangedAt, v))
+}
+
+// ChangedTx applies equality check predicate on the "changed_tx" field. It's identical to ChangedTxEQ.
+func ChangedTx(v int64) predicate.Favourite {
+	return predicate.Favourite(sql.FieldEQ(FieldChangedTx, v))
+}
+
 // UserIDEQ applies the EQ predicate on the "user_id" field.
 func UserIDEQ(v uuid.UUID) predicate.Favourite {
 	return predicate.Favourite(sql.FieldEQ(FieldUserID, v))
@@ -111,6 +126,71 @@ func AssetIDNotIn(vs ...uuid.UUID) predicate.Favourite {
 	return predicate.Favourite(sql.FieldNotIn(FieldAssetID, vs...))
 }
 
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.Favourite {
+	return predicate.Favourite(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.Favourite {
+	return predicate.Favourite(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.Favourite {
+	return predicate.Favourite(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.Favourite {
+	return predicate.Favourite(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.Favourite {
+	return predicate.Favourite(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.Favourite {
+	return predicate.Favourite(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.Favourite {
+	return predicate.Favourite(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.Favourite {
+	return predicate.Favourite(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.Favourite {
+	return predicate.Favourite(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.Favourite {
+	return predicate.Favourite(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.Favourite {
+	return predicate.Favourite(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.Favourite {
+	return predicate.Favourite(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.Favourite {
+	return predicate.Favourite(sql.FieldContainsFold(FieldNote, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Favourite {
 	return predicate.Favourite(sql.FieldEQ(FieldCreatedAt, v))
@@ -151,6 +231,96 @@ func CreatedAtLTE(v time.Time) predicate.Favourite {
 	return predicate.Favourite(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// ChangedAtEQ applies the EQ predicate on the "changed_at" field.
+func ChangedAtEQ(v time.Time) predicate.Favourite {
+	return predicate.Favourite(sql.FieldEQ(FieldChangedAt, v))
+}
+
+// ChangedAtNEQ applies the NEQ predicate on the "changed_at" field.
+func ChangedAtNEQ(v time.Time) predicate.Favourite {
+	return predicate.Favourite(sql.FieldNEQ(FieldChangedAt, v))
+}
+
+// ChangedAtIn applies the In predicate on the "changed_at" field.
+func ChangedAtIn(vs ...time.Time) predicate.Favourite {
+	return predicate.Favourite(sql.FieldIn(FieldChangedAt, vs...))
+}
+
+// ChangedAtNotIn applies the NotIn predicate on the "changed_at" field.
+func ChangedAtNotIn(vs ...time.Time) predicate.Favourite {
+	return predicate.Favourite(sql.FieldNotIn(FieldChangedAt, vs...))
+}
+
+// ChangedAtGT applies the GT predicate on the "changed_at" field.
+func ChangedAtGT(v time.Time) predicate.Favourite {
+	return predicate.Favourite(sql.FieldGT(FieldChangedAt, v))
+}
+
+// ChangedAtGTE applies the GTE predicate on the "changed_at" field.
+func ChangedAtGTE(v time.Time) predicate.Favourite {
+	return predicate.Favourite(sql.FieldGTE(FieldChangedAt, v))
+}
+
+// ChangedAtLT applies the LT predicate on the "changed_at" field.
+func ChangedAtLT(v time.Time) predicate.Favourite {
+	return predicate.Favourite(sql.FieldLT(FieldChangedAt, v))
+}
+
+// ChangedAtLTE applies the LTE predicate on the "changed_at" field.
+func ChangedAtLTE(v time.Time) predicate.Favourite {
+	return predicate.Favourite(sql.FieldLTE(FieldChangedAt, v))
+}
+
+// ChangedTxEQ applies the EQ predicate on the "changed_tx" field.
+func ChangedTxEQ(v int64) predicate.Favourite {
+	return predicate.Favourite(sql.FieldEQ(FieldChangedTx, v))
+}
+
+// ChangedTxNEQ applies the NEQ predicate on the "changed_tx" field.
+func ChangedTxNEQ(v int64) predicate.Favourite {
+	return predicate.Favourite(sql.FieldNEQ(FieldChangedTx, v))
+}
+
+// ChangedTxIn applies the In predicate on the "changed_tx" field.
+func ChangedTxIn(vs ...int64) predicate.Favourite {
+	return predicate.Favourite(sql.FieldIn(FieldChangedTx, vs...))
+}
+
+// ChangedTxNotIn applies the NotIn predicate on the "changed_tx" field.
+func ChangedTxNotIn(vs ...int64) predicate.Favourite {
+	return predicate.Favourite(sql.FieldNotIn(FieldChangedTx, vs...))
+}
+
+// ChangedTxGT applies the GT predicate on the "changed_tx" field.
+func ChangedTxGT(v int64) predicate.Favourite {
+	return predicate.Favourite(sql.FieldGT(FieldChangedTx, v))
+}
+
+// ChangedTxGTE applies the GTE predicate on the "changed_tx" field.
+func ChangedTxGTE(v int64) predicate.Favourite {
+	return predicate.Favourite(sql.FieldGTE(FieldChangedTx, v))
+}
+
+// ChangedTxLT applies the LT predicate on the "changed_tx" field.
+func ChangedTxLT(v int64) predicate.Favourite {
+	return predicate.Favourite(sql.FieldLT(FieldChangedTx, v))
+}
+
+// ChangedTxLTE applies the LTE predicate on the "changed_tx" field.
+func ChangedTxLTE(v int64) predicate.Favourite {
+	return predicate.Favourite(sql.FieldLTE(FieldChangedTx, v))
+}
+
+// ChangedTxIsNil applies the IsNil predicate on the "changed_tx" field.
+func ChangedTxIsNil() predicate.Favourite {
+	return predicate.Favourite(sql.FieldIsNull(FieldChangedTx))
+}
+
+// ChangedTxNotNil applies the NotNil predicate on the "changed_tx" field.
+func ChangedTxNotNil() predicate.Favourite {
+	return predicate.Favourite(sql.FieldNotNull(FieldChangedTx))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.Favourite {
 	return predicate.Favourite(func(s *sql.Selector) {
@@ -197,6 +367,29 @@ func HasAssetWith(preds ...predicate.Asset) predicate.Favourite {
 	})
 }
 
+// HasCollections applies the HasEdge predicate on the "collections" edge.
+func HasCollections() predicate.Favourite {
+	return predicate.Favourite(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, CollectionsTable, CollectionsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCollectionsWith applies the HasEdge predicate on the "collections" edge with a given conditions (other predicates).
+func HasCollectionsWith(preds ...predicate.Collection) predicate.Favourite {
+	return predicate.Favourite(func(s *sql.Selector) {
+		step := newCollectionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Favourite) predicate.Favourite {
 	return predicate.Favourite(sql.AndPredicates(predicates...))
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/SokratisChaimanas/platform-go-challenge/ent/asset"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/collection"
 	"github.com/SokratisChaimanas/platform-go-challenge/ent/favourite"
 	"github.com/SokratisChaimanas/platform-go-challenge/ent/user"
 	"github.com/google/uuid"
@@ -35,6 +36,20 @@ func (_c *FavouriteCreate) SetAssetID(v uuid.UUID) *FavouriteCreate {
 	return _c
 }
 
+// SetNote sets the "note" field.
+func (_c *FavouriteCreate) SetNote(v string) *FavouriteCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *FavouriteCreate) SetNillableNote(v *string) *FavouriteCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *FavouriteCreate) SetCreatedAt(v time.Time) *FavouriteCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -49,6 +64,34 @@ func (_c *FavouriteCreate) SetNillableCreatedAt(v *time.Time) *FavouriteCreate {
 	return _c
 }
 
+// SetChangedAt sets the "changed_at" field.
+func (_c *FavouriteCreate) SetChangedAt(v time.Time) *FavouriteCreate {
+	_c.mutation.SetChangedAt(v)
+	return _c
+}
+
+// SetNillableChangedAt sets the "changed_at" field if the given value is not nil.
+func (_c *FavouriteCreate) SetNillableChangedAt(v *time.Time) *FavouriteCreate {
+	if v != nil {
+		_c.SetChangedAt(*v)
+	}
+	return _c
+}
+
+// SetChangedTx sets the "changed_tx" field.
+func (_c *FavouriteCreate) SetChangedTx(v int64) *FavouriteCreate {
+	_c.mutation.SetChangedTx(v)
+	return _c
+}
+
+// SetNillableChangedTx sets the "changed_tx" field if the given value is not nil.
+func (_c *FavouriteCreate) SetNillableChangedTx(v *int64) *FavouriteCreate {
+	if v != nil {
+		_c.SetChangedTx(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *FavouriteCreate) SetID(v uuid.UUID) *FavouriteCreate {
 	_c.mutation.SetID(v)
@@ -73,6 +116,21 @@ func (_c *FavouriteCreate) SetAsset(v *Asset) *FavouriteCreate {
 	return _c.SetAssetID(v.ID)
 }
 
+// AddCollectionIDs adds the "collections" edge to the Collection entity by IDs.
+func (_c *FavouriteCreate) AddCollectionIDs(ids ...uuid.UUID) *FavouriteCreate {
+	_c.mutation.AddCollectionIDs(ids...)
+	return _c
+}
+
+// AddCollections adds the "collections" edges to the Collection entity.
+func (_c *FavouriteCreate) AddCollections(v ...*Collection) *FavouriteCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCollectionIDs(ids...)
+}
+
 // Mutation returns the FavouriteMutation object of the builder.
 func (_c *FavouriteCreate) Mutation() *FavouriteMutation {
 	return _c.mutation
@@ -108,10 +166,18 @@ func (_c *FavouriteCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *FavouriteCreate) defaults() {
+	if _, ok := _c.mutation.Note(); !ok {
+		v := favourite.DefaultNote
+		_c.mutation.SetNote(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := favourite.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
+	if _, ok := _c.mutation.ChangedAt(); !ok {
+		v := favourite.DefaultChangedAt()
+		_c.mutation.SetChangedAt(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := favourite.DefaultID()
 		_c.mutation.SetID(v)
@@ -126,9 +192,15 @@ func (_c *FavouriteCreate) check() error {
 	if _, ok := _c.mutation.AssetID(); !ok {
 		return &ValidationError{Name: "asset_id", err: errors.New(`ent: missing required field "Favourite.asset_id"`)}
 	}
+	if _, ok := _c.mutation.Note(); !ok {
+		return &ValidationError{Name: "note", err: errors.New(`ent: missing required field "Favourite.note"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Favourite.created_at"`)}
 	}
+	if _, ok := _c.mutation.ChangedAt(); !ok {
+		return &ValidationError{Name: "changed_at", err: errors.New(`ent: missing required field "Favourite.changed_at"`)}
+	}
 	if len(_c.mutation.UserIDs()) == 0 {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Favourite.user"`)}
 	}
@@ -170,10 +242,22 @@ func (_c *FavouriteCreate) createSpec() (*Favourite, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(favourite.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(favourite.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := _c.mutation.ChangedAt(); ok {
+		_spec.SetField(favourite.FieldChangedAt, field.TypeTime, value)
+		_node.ChangedAt = value
+	}
+	if value, ok := _c.mutation.ChangedTx(); ok {
+		_spec.SetField(favourite.FieldChangedTx, field.TypeInt64, value)
+		_node.ChangedTx = value
+	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -208,6 +292,22 @@ func (_c *FavouriteCreate) createSpec() (*Favourite, *sqlgraph.CreateSpec) {
 		_node.AssetID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.CollectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   favourite.CollectionsTable,
+			Columns: favourite.CollectionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -4,14 +4,17 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/SokratisChaimanas/platform-go-challenge/ent/asset"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/collection"
 	"github.com/SokratisChaimanas/platform-go-challenge/ent/favourite"
 	"github.com/SokratisChaimanas/platform-go-challenge/ent/predicate"
 	"github.com/SokratisChaimanas/platform-go-challenge/ent/user"
@@ -21,12 +24,14 @@ import (
 // FavouriteQuery is the builder for querying Favourite entities.
 type FavouriteQuery struct {
 	config
-	ctx        *QueryContext
-	order      []favourite.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Favourite
-	withUser   *UserQuery
-	withAsset  *AssetQuery
+	ctx             *QueryContext
+	order           []favourite.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.Favourite
+	withUser        *UserQuery
+	withAsset       *AssetQuery
+	withCollections *CollectionQuery
+	modifiers       []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +112,28 @@ func (_q *FavouriteQuery) QueryAsset() *AssetQuery {
 	return query
 }
 
+// QueryCollections chains the current query on the "collections" edge.
+func (_q *FavouriteQuery) QueryCollections() *CollectionQuery {
+	query := (&CollectionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(favourite.Table, favourite.FieldID, selector),
+			sqlgraph.To(collection.Table, collection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, favourite.CollectionsTable, favourite.CollectionsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Favourite entity from the query.
 // Returns a *NotFoundError when no Favourite was found.
 func (_q *FavouriteQuery) First(ctx context.Context) (*Favourite, error) {
@@ -294,13 +321,14 @@ func (_q *FavouriteQuery) Clone() *FavouriteQuery {
 		return nil
 	}
 	return &FavouriteQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]favourite.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.Favourite{}, _q.predicates...),
-		withUser:   _q.withUser.Clone(),
-		withAsset:  _q.withAsset.Clone(),
+		config:          _q.config,
+		ctx:             _q.ctx.Clone(),
+		order:           append([]favourite.OrderOption{}, _q.order...),
+		inters:          append([]Interceptor{}, _q.inters...),
+		predicates:      append([]predicate.Favourite{}, _q.predicates...),
+		withUser:        _q.withUser.Clone(),
+		withAsset:       _q.withAsset.Clone(),
+		withCollections: _q.withCollections.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -329,6 +357,17 @@ func (_q *FavouriteQuery) WithAsset(opts ...func(*AssetQuery)) *FavouriteQuery {
 	return _q
 }
 
+// WithCollections tells the query-builder to eager-load the nodes that are connected to
+// the "collections" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *FavouriteQuery) WithCollections(opts ...func(*CollectionQuery)) *FavouriteQuery {
+	query := (&CollectionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCollections = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +446,10 @@ func (_q *FavouriteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Fa
 	var (
 		nodes       = []*Favourite{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			_q.withUser != nil,
 			_q.withAsset != nil,
+			_q.withCollections != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -421,6 +461,9 @@ func (_q *FavouriteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Fa
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -442,6 +485,13 @@ func (_q *FavouriteQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Fa
 			return nil, err
 		}
 	}
+	if query := _q.withCollections; query != nil {
+		if err := _q.loadCollections(ctx, query, nodes,
+			func(n *Favourite) { n.Edges.Collections = []*Collection{} },
+			func(n *Favourite, e *Collection) { n.Edges.Collections = append(n.Edges.Collections, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -503,9 +553,73 @@ func (_q *FavouriteQuery) loadAsset(ctx context.Context, query *AssetQuery, node
 	}
 	return nil
 }
+func (_q *FavouriteQuery) loadCollections(ctx context.Context, query *CollectionQuery, nodes []*Favourite, init func(*Favourite), assign func(*Favourite, *Collection)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*Favourite)
+	nids := make(map[uuid.UUID]map[*Favourite]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(favourite.CollectionsTable)
+		s.Join(joinT).On(s.C(collection.FieldID), joinT.C(favourite.CollectionsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(favourite.CollectionsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(favourite.CollectionsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Favourite]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Collection](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "collections" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 
 func (_q *FavouriteQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -574,6 +688,9 @@ func (_q *FavouriteQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -591,6 +708,32 @@ func (_q *FavouriteQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *FavouriteQuery) ForUpdate(opts ...sql.LockOption) *FavouriteQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *FavouriteQuery) ForShare(opts ...sql.LockOption) *FavouriteQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
 // FavouriteGroupBy is the group-by builder for Favourite entities.
 type FavouriteGroupBy struct {
 	selector
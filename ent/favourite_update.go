@@ -6,11 +6,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/SokratisChaimanas/platform-go-challenge/ent/asset"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/collection"
 	"github.com/SokratisChaimanas/platform-go-challenge/ent/favourite"
 	"github.com/SokratisChaimanas/platform-go-challenge/ent/predicate"
 	"github.com/SokratisChaimanas/platform-go-challenge/ent/user"
@@ -58,6 +60,61 @@ func (_u *FavouriteUpdate) SetNillableAssetID(v *uuid.UUID) *FavouriteUpdate {
 	return _u
 }
 
+// SetNote sets the "note" field.
+func (_u *FavouriteUpdate) SetNote(v string) *FavouriteUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *FavouriteUpdate) SetNillableNote(v *string) *FavouriteUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// SetChangedAt sets the "changed_at" field.
+func (_u *FavouriteUpdate) SetChangedAt(v time.Time) *FavouriteUpdate {
+	_u.mutation.SetChangedAt(v)
+	return _u
+}
+
+// SetNillableChangedAt sets the "changed_at" field if the given value is not nil.
+func (_u *FavouriteUpdate) SetNillableChangedAt(v *time.Time) *FavouriteUpdate {
+	if v != nil {
+		_u.SetChangedAt(*v)
+	}
+	return _u
+}
+
+// SetChangedTx sets the "changed_tx" field.
+func (_u *FavouriteUpdate) SetChangedTx(v int64) *FavouriteUpdate {
+	_u.mutation.ResetChangedTx()
+	_u.mutation.SetChangedTx(v)
+	return _u
+}
+
+// SetNillableChangedTx sets the "changed_tx" field if the given value is not nil.
+func (_u *FavouriteUpdate) SetNillableChangedTx(v *int64) *FavouriteUpdate {
+	if v != nil {
+		_u.SetChangedTx(*v)
+	}
+	return _u
+}
+
+// AddChangedTx adds value to the "changed_tx" field.
+func (_u *FavouriteUpdate) AddChangedTx(v int64) *FavouriteUpdate {
+	_u.mutation.AddChangedTx(v)
+	return _u
+}
+
+// ClearChangedTx clears the value of the "changed_tx" field.
+func (_u *FavouriteUpdate) ClearChangedTx() *FavouriteUpdate {
+	_u.mutation.ClearChangedTx()
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *FavouriteUpdate) SetUser(v *User) *FavouriteUpdate {
 	return _u.SetUserID(v.ID)
@@ -68,6 +125,21 @@ func (_u *FavouriteUpdate) SetAsset(v *Asset) *FavouriteUpdate {
 	return _u.SetAssetID(v.ID)
 }
 
+// AddCollectionIDs adds the "collections" edge to the Collection entity by IDs.
+func (_u *FavouriteUpdate) AddCollectionIDs(ids ...uuid.UUID) *FavouriteUpdate {
+	_u.mutation.AddCollectionIDs(ids...)
+	return _u
+}
+
+// AddCollections adds the "collections" edges to the Collection entity.
+func (_u *FavouriteUpdate) AddCollections(v ...*Collection) *FavouriteUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCollectionIDs(ids...)
+}
+
 // Mutation returns the FavouriteMutation object of the builder.
 func (_u *FavouriteUpdate) Mutation() *FavouriteMutation {
 	return _u.mutation
@@ -85,6 +157,27 @@ func (_u *FavouriteUpdate) ClearAsset() *FavouriteUpdate {
 	return _u
 }
 
+// ClearCollections clears all "collections" edges to the Collection entity.
+func (_u *FavouriteUpdate) ClearCollections() *FavouriteUpdate {
+	_u.mutation.ClearCollections()
+	return _u
+}
+
+// RemoveCollectionIDs removes the "collections" edge to Collection entities by IDs.
+func (_u *FavouriteUpdate) RemoveCollectionIDs(ids ...uuid.UUID) *FavouriteUpdate {
+	_u.mutation.RemoveCollectionIDs(ids...)
+	return _u
+}
+
+// RemoveCollections removes "collections" edges to Collection entities.
+func (_u *FavouriteUpdate) RemoveCollections(v ...*Collection) *FavouriteUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCollectionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *FavouriteUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -135,6 +228,21 @@ func (_u *FavouriteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(favourite.FieldNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ChangedAt(); ok {
+		_spec.SetField(favourite.FieldChangedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ChangedTx(); ok {
+		_spec.SetField(favourite.FieldChangedTx, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChangedTx(); ok {
+		_spec.AddField(favourite.FieldChangedTx, field.TypeInt64, value)
+	}
+	if _u.mutation.ChangedTxCleared() {
+		_spec.ClearField(favourite.FieldChangedTx, field.TypeInt64)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -193,6 +301,51 @@ func (_u *FavouriteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.CollectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   favourite.CollectionsTable,
+			Columns: favourite.CollectionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCollectionsIDs(); len(nodes) > 0 && !_u.mutation.CollectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   favourite.CollectionsTable,
+			Columns: favourite.CollectionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CollectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   favourite.CollectionsTable,
+			Columns: favourite.CollectionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{favourite.Label}
@@ -241,6 +394,61 @@ func (_u *FavouriteUpdateOne) SetNillableAssetID(v *uuid.UUID) *FavouriteUpdateO
 	return _u
 }
 
+// SetNote sets the "note" field.
+func (_u *FavouriteUpdateOne) SetNote(v string) *FavouriteUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *FavouriteUpdateOne) SetNillableNote(v *string) *FavouriteUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// SetChangedAt sets the "changed_at" field.
+func (_u *FavouriteUpdateOne) SetChangedAt(v time.Time) *FavouriteUpdateOne {
+	_u.mutation.SetChangedAt(v)
+	return _u
+}
+
+// SetNillableChangedAt sets the "changed_at" field if the given value is not nil.
+func (_u *FavouriteUpdateOne) SetNillableChangedAt(v *time.Time) *FavouriteUpdateOne {
+	if v != nil {
+		_u.SetChangedAt(*v)
+	}
+	return _u
+}
+
+// SetChangedTx sets the "changed_tx" field.
+func (_u *FavouriteUpdateOne) SetChangedTx(v int64) *FavouriteUpdateOne {
+	_u.mutation.ResetChangedTx()
+	_u.mutation.SetChangedTx(v)
+	return _u
+}
+
+// SetNillableChangedTx sets the "changed_tx" field if the given value is not nil.
+func (_u *FavouriteUpdateOne) SetNillableChangedTx(v *int64) *FavouriteUpdateOne {
+	if v != nil {
+		_u.SetChangedTx(*v)
+	}
+	return _u
+}
+
+// AddChangedTx adds value to the "changed_tx" field.
+func (_u *FavouriteUpdateOne) AddChangedTx(v int64) *FavouriteUpdateOne {
+	_u.mutation.AddChangedTx(v)
+	return _u
+}
+
+// ClearChangedTx clears the value of the "changed_tx" field.
+func (_u *FavouriteUpdateOne) ClearChangedTx() *FavouriteUpdateOne {
+	_u.mutation.ClearChangedTx()
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *FavouriteUpdateOne) SetUser(v *User) *FavouriteUpdateOne {
 	return _u.SetUserID(v.ID)
@@ -251,6 +459,21 @@ func (_u *FavouriteUpdateOne) SetAsset(v *Asset) *FavouriteUpdateOne {
 	return _u.SetAssetID(v.ID)
 }
 
+// AddCollectionIDs adds the "collections" edge to the Collection entity by IDs.
+func (_u *FavouriteUpdateOne) AddCollectionIDs(ids ...uuid.UUID) *FavouriteUpdateOne {
+	_u.mutation.AddCollectionIDs(ids...)
+	return _u
+}
+
+// AddCollections adds the "collections" edges to the Collection entity.
+func (_u *FavouriteUpdateOne) AddCollections(v ...*Collection) *FavouriteUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCollectionIDs(ids...)
+}
+
 // Mutation returns the FavouriteMutation object of the builder.
 func (_u *FavouriteUpdateOne) Mutation() *FavouriteMutation {
 	return _u.mutation
@@ -268,6 +491,27 @@ func (_u *FavouriteUpdateOne) ClearAsset() *FavouriteUpdateOne {
 	return _u
 }
 
+// ClearCollections clears all "collections" edges to the Collection entity.
+func (_u *FavouriteUpdateOne) ClearCollections() *FavouriteUpdateOne {
+	_u.mutation.ClearCollections()
+	return _u
+}
+
+// RemoveCollectionIDs removes the "collections" edge to Collection entities by IDs.
+func (_u *FavouriteUpdateOne) RemoveCollectionIDs(ids ...uuid.UUID) *FavouriteUpdateOne {
+	_u.mutation.RemoveCollectionIDs(ids...)
+	return _u
+}
+
+// RemoveCollections removes "collections" edges to Collection entities.
+func (_u *FavouriteUpdateOne) RemoveCollections(v ...*Collection) *FavouriteUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCollectionIDs(ids...)
+}
+
 // Where appends a list predicates to the FavouriteUpdate builder.
 func (_u *FavouriteUpdateOne) Where(ps ...predicate.Favourite) *FavouriteUpdateOne {
 	_u.mutation.Where(ps...)
@@ -348,6 +592,21 @@ func (_u *FavouriteUpdateOne) sqlSave(ctx context.Context) (_node *Favourite, er
 			}
 		}
 	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(favourite.FieldNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ChangedAt(); ok {
+		_spec.SetField(favourite.FieldChangedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ChangedTx(); ok {
+		_spec.SetField(favourite.FieldChangedTx, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChangedTx(); ok {
+		_spec.AddField(favourite.FieldChangedTx, field.TypeInt64, value)
+	}
+	if _u.mutation.ChangedTxCleared() {
+		_spec.ClearField(favourite.FieldChangedTx, field.TypeInt64)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -406,6 +665,51 @@ func (_u *FavouriteUpdateOne) sqlSave(ctx context.Context) (_node *Favourite, er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.CollectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   favourite.CollectionsTable,
+			Columns: favourite.CollectionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCollectionsIDs(); len(nodes) > 0 && !_u.mutation.CollectionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   favourite.CollectionsTable,
+			Columns: favourite.CollectionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CollectionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   favourite.CollectionsTable,
+			Columns: favourite.CollectionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Favourite{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,140 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/favouritetombstone"
+	"github.com/google/uuid"
+)
+
+// FavouriteTombstone is the model entity for the FavouriteTombstone schema.
+type FavouriteTombstone struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// AssetID holds the value of the "asset_id" field.
+	AssetID uuid.UUID `json:"asset_id,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// DeletedTx holds the value of the "deleted_tx" field.
+	DeletedTx    int64 `json:"deleted_tx,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FavouriteTombstone) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case favouritetombstone.FieldDeletedTx:
+			values[i] = new(sql.NullInt64)
+		case favouritetombstone.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case favouritetombstone.FieldID, favouritetombstone.FieldUserID, favouritetombstone.FieldAssetID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FavouriteTombstone fields.
+func (_m *FavouriteTombstone) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case favouritetombstone.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case favouritetombstone.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case favouritetombstone.FieldAssetID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field asset_id", values[i])
+			} else if value != nil {
+				_m.AssetID = *value
+			}
+		case favouritetombstone.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = value.Time
+			}
+		case favouritetombstone.FieldDeletedTx:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_tx", values[i])
+			} else if value.Valid {
+				_m.DeletedTx = value.Int64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FavouriteTombstone.
+// This includes values selected through modifiers, order, etc.
+func (_m *FavouriteTombstone) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this FavouriteTombstone.
+// Note that you need to call FavouriteTombstone.Unwrap() before calling this method if this FavouriteTombstone
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *FavouriteTombstone) Update() *FavouriteTombstoneUpdateOne {
+	return NewFavouriteTombstoneClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the FavouriteTombstone entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *FavouriteTombstone) Unwrap() *FavouriteTombstone {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FavouriteTombstone is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *FavouriteTombstone) String() string {
+	var builder strings.Builder
+	builder.WriteString("FavouriteTombstone(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("asset_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AssetID))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(_m.DeletedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_tx=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedTx))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FavouriteTombstones is a parsable slice of FavouriteTombstone.
+type FavouriteTombstones []*FavouriteTombstone
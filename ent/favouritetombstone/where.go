@@ -0,0 +1,261 @@
+// Code generated by ent, DO NOT EDIT.
+
+package favouritetombstone
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldEQ(FieldUserID, v))
+}
+
+// AssetID applies equality check predicate on the "asset_id" field. It's identical to AssetIDEQ.
+func AssetID(v uuid.UUID) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldEQ(FieldAssetID, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedTx applies equality check predicate on the "deleted_tx" field. It's identical to DeletedTxEQ.
+func DeletedTx(v int64) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldEQ(FieldDeletedTx, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldLTE(FieldUserID, v))
+}
+
+// AssetIDEQ applies the EQ predicate on the "asset_id" field.
+func AssetIDEQ(v uuid.UUID) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldEQ(FieldAssetID, v))
+}
+
+// AssetIDNEQ applies the NEQ predicate on the "asset_id" field.
+func AssetIDNEQ(v uuid.UUID) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldNEQ(FieldAssetID, v))
+}
+
+// AssetIDIn applies the In predicate on the "asset_id" field.
+func AssetIDIn(vs ...uuid.UUID) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldIn(FieldAssetID, vs...))
+}
+
+// AssetIDNotIn applies the NotIn predicate on the "asset_id" field.
+func AssetIDNotIn(vs ...uuid.UUID) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldNotIn(FieldAssetID, vs...))
+}
+
+// AssetIDGT applies the GT predicate on the "asset_id" field.
+func AssetIDGT(v uuid.UUID) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldGT(FieldAssetID, v))
+}
+
+// AssetIDGTE applies the GTE predicate on the "asset_id" field.
+func AssetIDGTE(v uuid.UUID) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldGTE(FieldAssetID, v))
+}
+
+// AssetIDLT applies the LT predicate on the "asset_id" field.
+func AssetIDLT(v uuid.UUID) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldLT(FieldAssetID, v))
+}
+
+// AssetIDLTE applies the LTE predicate on the "asset_id" field.
+func AssetIDLTE(v uuid.UUID) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldLTE(FieldAssetID, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedTxEQ applies the EQ predicate on the "deleted_tx" field.
+func DeletedTxEQ(v int64) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldEQ(FieldDeletedTx, v))
+}
+
+// DeletedTxNEQ applies the NEQ predicate on the "deleted_tx" field.
+func DeletedTxNEQ(v int64) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldNEQ(FieldDeletedTx, v))
+}
+
+// DeletedTxIn applies the In predicate on the "deleted_tx" field.
+func DeletedTxIn(vs ...int64) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldIn(FieldDeletedTx, vs...))
+}
+
+// DeletedTxNotIn applies the NotIn predicate on the "deleted_tx" field.
+func DeletedTxNotIn(vs ...int64) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldNotIn(FieldDeletedTx, vs...))
+}
+
+// DeletedTxGT applies the GT predicate on the "deleted_tx" field.
+func DeletedTxGT(v int64) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldGT(FieldDeletedTx, v))
+}
+
+// DeletedTxGTE applies the GTE predicate on the "deleted_tx" field.
+func DeletedTxGTE(v int64) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldGTE(FieldDeletedTx, v))
+}
+
+// DeletedTxLT applies the LT predicate on the "deleted_tx" field.
+func DeletedTxLT(v int64) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldLT(FieldDeletedTx, v))
+}
+
+// DeletedTxLTE applies the LTE predicate on the "deleted_tx" field.
+func DeletedTxLTE(v int64) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldLTE(FieldDeletedTx, v))
+}
+
+// DeletedTxIsNil applies the IsNil predicate on the "deleted_tx" field.
+func DeletedTxIsNil() predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldIsNull(FieldDeletedTx))
+}
+
+// DeletedTxNotNil applies the NotNil predicate on the "deleted_tx" field.
+func DeletedTxNotNil() predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.FieldNotNull(FieldDeletedTx))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FavouriteTombstone) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FavouriteTombstone) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FavouriteTombstone) predicate.FavouriteTombstone {
+	return predicate.FavouriteTombstone(sql.NotPredicates(p))
+}
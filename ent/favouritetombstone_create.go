@@ -0,0 +1,270 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/favouritetombstone"
+	"github.com/google/uuid"
+)
+
+// FavouriteTombstoneCreate is the builder for creating a FavouriteTombstone entity.
+type FavouriteTombstoneCreate struct {
+	config
+	mutation *FavouriteTombstoneMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *FavouriteTombstoneCreate) SetUserID(v uuid.UUID) *FavouriteTombstoneCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetAssetID sets the "asset_id" field.
+func (_c *FavouriteTombstoneCreate) SetAssetID(v uuid.UUID) *FavouriteTombstoneCreate {
+	_c.mutation.SetAssetID(v)
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *FavouriteTombstoneCreate) SetDeletedAt(v time.Time) *FavouriteTombstoneCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *FavouriteTombstoneCreate) SetNillableDeletedAt(v *time.Time) *FavouriteTombstoneCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedTx sets the "deleted_tx" field.
+func (_c *FavouriteTombstoneCreate) SetDeletedTx(v int64) *FavouriteTombstoneCreate {
+	_c.mutation.SetDeletedTx(v)
+	return _c
+}
+
+// SetNillableDeletedTx sets the "deleted_tx" field if the given value is not nil.
+func (_c *FavouriteTombstoneCreate) SetNillableDeletedTx(v *int64) *FavouriteTombstoneCreate {
+	if v != nil {
+		_c.SetDeletedTx(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *FavouriteTombstoneCreate) SetID(v uuid.UUID) *FavouriteTombstoneCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *FavouriteTombstoneCreate) SetNillableID(v *uuid.UUID) *FavouriteTombstoneCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the FavouriteTombstoneMutation object of the builder.
+func (_c *FavouriteTombstoneCreate) Mutation() *FavouriteTombstoneMutation {
+	return _c.mutation
+}
+
+// Save creates the FavouriteTombstone in the database.
+func (_c *FavouriteTombstoneCreate) Save(ctx context.Context) (*FavouriteTombstone, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *FavouriteTombstoneCreate) SaveX(ctx context.Context) *FavouriteTombstone {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FavouriteTombstoneCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FavouriteTombstoneCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *FavouriteTombstoneCreate) defaults() {
+	if _, ok := _c.mutation.DeletedAt(); !ok {
+		v := favouritetombstone.DefaultDeletedAt()
+		_c.mutation.SetDeletedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := favouritetombstone.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *FavouriteTombstoneCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "FavouriteTombstone.user_id"`)}
+	}
+	if _, ok := _c.mutation.AssetID(); !ok {
+		return &ValidationError{Name: "asset_id", err: errors.New(`ent: missing required field "FavouriteTombstone.asset_id"`)}
+	}
+	if _, ok := _c.mutation.DeletedAt(); !ok {
+		return &ValidationError{Name: "deleted_at", err: errors.New(`ent: missing required field "FavouriteTombstone.deleted_at"`)}
+	}
+	return nil
+}
+
+func (_c *FavouriteTombstoneCreate) sqlSave(ctx context.Context) (*FavouriteTombstone, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *FavouriteTombstoneCreate) createSpec() (*FavouriteTombstone, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FavouriteTombstone{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(favouritetombstone.Table, sqlgraph.NewFieldSpec(favouritetombstone.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(favouritetombstone.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.AssetID(); ok {
+		_spec.SetField(favouritetombstone.FieldAssetID, field.TypeUUID, value)
+		_node.AssetID = value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(favouritetombstone.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := _c.mutation.DeletedTx(); ok {
+		_spec.SetField(favouritetombstone.FieldDeletedTx, field.TypeInt64, value)
+		_node.DeletedTx = value
+	}
+	return _node, _spec
+}
+
+// FavouriteTombstoneCreateBulk is the builder for creating many FavouriteTombstone entities in bulk.
+type FavouriteTombstoneCreateBulk struct {
+	config
+	err      error
+	builders []*FavouriteTombstoneCreate
+}
+
+// Save creates the FavouriteTombstone entities in the database.
+func (_c *FavouriteTombstoneCreateBulk) Save(ctx context.Context) ([]*FavouriteTombstone, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*FavouriteTombstone, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FavouriteTombstoneMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FavouriteTombstoneCreateBulk) SaveX(ctx context.Context) []*FavouriteTombstone {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FavouriteTombstoneCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FavouriteTombstoneCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/favouritetombstone"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/predicate"
+)
+
+// FavouriteTombstoneDelete is the builder for deleting a FavouriteTombstone entity.
+type FavouriteTombstoneDelete struct {
+	config
+	hooks    []Hook
+	mutation *FavouriteTombstoneMutation
+}
+
+// Where appends a list predicates to the FavouriteTombstoneDelete builder.
+func (_d *FavouriteTombstoneDelete) Where(ps ...predicate.FavouriteTombstone) *FavouriteTombstoneDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *FavouriteTombstoneDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *FavouriteTombstoneDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *FavouriteTombstoneDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(favouritetombstone.Table, sqlgraph.NewFieldSpec(favouritetombstone.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// FavouriteTombstoneDeleteOne is the builder for deleting a single FavouriteTombstone entity.
+type FavouriteTombstoneDeleteOne struct {
+	_d *FavouriteTombstoneDelete
+}
+
+// Where appends a list predicates to the FavouriteTombstoneDelete builder.
+func (_d *FavouriteTombstoneDeleteOne) Where(ps ...predicate.FavouriteTombstone) *FavouriteTombstoneDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *FavouriteTombstoneDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{favouritetombstone.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *FavouriteTombstoneDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
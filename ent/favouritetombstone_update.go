@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/favouritetombstone"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/predicate"
+)
+
+// FavouriteTombstoneUpdate is the builder for updating FavouriteTombstone entities.
+type FavouriteTombstoneUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FavouriteTombstoneMutation
+}
+
+// Where appends a list predicates to the FavouriteTombstoneUpdate builder.
+func (_u *FavouriteTombstoneUpdate) Where(ps ...predicate.FavouriteTombstone) *FavouriteTombstoneUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the FavouriteTombstoneMutation object of the builder.
+func (_u *FavouriteTombstoneUpdate) Mutation() *FavouriteTombstoneMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FavouriteTombstoneUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FavouriteTombstoneUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FavouriteTombstoneUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FavouriteTombstoneUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *FavouriteTombstoneUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(favouritetombstone.Table, favouritetombstone.Columns, sqlgraph.NewFieldSpec(favouritetombstone.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.DeletedTxCleared() {
+		_spec.ClearField(favouritetombstone.FieldDeletedTx, field.TypeInt64)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{favouritetombstone.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FavouriteTombstoneUpdateOne is the builder for updating a single FavouriteTombstone entity.
+type FavouriteTombstoneUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FavouriteTombstoneMutation
+}
+
+// Mutation returns the FavouriteTombstoneMutation object of the builder.
+func (_u *FavouriteTombstoneUpdateOne) Mutation() *FavouriteTombstoneMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the FavouriteTombstoneUpdate builder.
+func (_u *FavouriteTombstoneUpdateOne) Where(ps ...predicate.FavouriteTombstone) *FavouriteTombstoneUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *FavouriteTombstoneUpdateOne) Select(field string, fields ...string) *FavouriteTombstoneUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated FavouriteTombstone entity.
+func (_u *FavouriteTombstoneUpdateOne) Save(ctx context.Context) (*FavouriteTombstone, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FavouriteTombstoneUpdateOne) SaveX(ctx context.Context) *FavouriteTombstone {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *FavouriteTombstoneUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FavouriteTombstoneUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *FavouriteTombstoneUpdateOne) sqlSave(ctx context.Context) (_node *FavouriteTombstone, err error) {
+	_spec := sqlgraph.NewUpdateSpec(favouritetombstone.Table, favouritetombstone.Columns, sqlgraph.NewFieldSpec(favouritetombstone.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FavouriteTombstone.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, favouritetombstone.FieldID)
+		for _, f := range fields {
+			if !favouritetombstone.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != favouritetombstone.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.DeletedTxCleared() {
+		_spec.ClearField(favouritetombstone.FieldDeletedTx, field.TypeInt64)
+	}
+	_node = &FavouriteTombstone{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{favouritetombstone.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
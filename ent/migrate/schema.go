@@ -14,18 +14,140 @@ var (
 		{Name: "asset_type", Type: field.TypeEnum, Enums: []string{"chart", "insight", "audience"}},
 		{Name: "description", Type: field.TypeString},
 		{Name: "payload", Type: field.TypeJSON, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "archived_at", Type: field.TypeTime, Nullable: true},
 	}
 	// AssetsTable holds the schema information for the "assets" table.
 	AssetsTable = &schema.Table{
 		Name:       "assets",
 		Columns:    AssetsColumns,
 		PrimaryKey: []*schema.Column{AssetsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "asset_created_at_id",
+				Unique:  false,
+				Columns: []*schema.Column{AssetsColumns[5], AssetsColumns[0]},
+			},
+			{
+				Name:    "asset_asset_type_created_at_id",
+				Unique:  false,
+				Columns: []*schema.Column{AssetsColumns[1], AssetsColumns[5], AssetsColumns[0]},
+			},
+		},
+	}
+	// AssetRevisionsColumns holds the columns for the "asset_revisions" table.
+	AssetRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "old_description", Type: field.TypeString},
+		{Name: "new_description", Type: field.TypeString},
+		{Name: "editor_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "asset_id", Type: field.TypeUUID},
+	}
+	// AssetRevisionsTable holds the schema information for the "asset_revisions" table.
+	AssetRevisionsTable = &schema.Table{
+		Name:       "asset_revisions",
+		Columns:    AssetRevisionsColumns,
+		PrimaryKey: []*schema.Column{AssetRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "asset_revisions_assets_revisions",
+				Columns:    []*schema.Column{AssetRevisionsColumns[5]},
+				RefColumns: []*schema.Column{AssetsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "assetrevision_asset_id_created_at_id",
+				Unique:  false,
+				Columns: []*schema.Column{AssetRevisionsColumns[5], AssetRevisionsColumns[4], AssetRevisionsColumns[0]},
+			},
+		},
+	}
+	// AuditEntriesColumns holds the columns for the "audit_entries" table.
+	AuditEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "claimed_actor_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "request_id", Type: field.TypeString, Default: ""},
+		{Name: "action", Type: field.TypeString},
+		{Name: "entity_type", Type: field.TypeString},
+		{Name: "entity_id", Type: field.TypeUUID},
+		{Name: "asset_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "user_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "before", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "after", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+	}
+	// AuditEntriesTable holds the schema information for the "audit_entries" table.
+	AuditEntriesTable = &schema.Table{
+		Name:       "audit_entries",
+		Columns:    AuditEntriesColumns,
+		PrimaryKey: []*schema.Column{AuditEntriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditentry_created_at_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[1], AuditEntriesColumns[0]},
+			},
+			{
+				Name:    "auditentry_asset_id_created_at_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[7], AuditEntriesColumns[1], AuditEntriesColumns[0]},
+			},
+			{
+				Name:    "auditentry_user_id_created_at_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[8], AuditEntriesColumns[1], AuditEntriesColumns[0]},
+			},
+			{
+				Name:    "auditentry_claimed_actor_id_created_at_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[2], AuditEntriesColumns[1], AuditEntriesColumns[0]},
+			},
+			{
+				Name:    "auditentry_request_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEntriesColumns[3]},
+			},
+		},
+	}
+	// CollectionsColumns holds the columns for the "collections" table.
+	CollectionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "varchar(100)"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// CollectionsTable holds the schema information for the "collections" table.
+	CollectionsTable = &schema.Table{
+		Name:       "collections",
+		Columns:    CollectionsColumns,
+		PrimaryKey: []*schema.Column{CollectionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "collections_users_collections",
+				Columns:    []*schema.Column{CollectionsColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "collection_user_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{CollectionsColumns[3], CollectionsColumns[1]},
+			},
+		},
 	}
 	// FavouritesColumns holds the columns for the "favourites" table.
 	FavouritesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "note", Type: field.TypeString, Default: "", SchemaType: map[string]string{"postgres": "varchar(500)"}},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "changed_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "changed_tx", Type: field.TypeInt64, Nullable: true, Default: schema.Expr("(pg_current_xact_id()::text::bigint)")},
 		{Name: "asset_id", Type: field.TypeUUID},
 		{Name: "user_id", Type: field.TypeUUID},
 	}
@@ -37,13 +159,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "favourites_assets_favourites",
-				Columns:    []*schema.Column{FavouritesColumns[2]},
+				Columns:    []*schema.Column{FavouritesColumns[5]},
 				RefColumns: []*schema.Column{AssetsColumns[0]},
-				OnDelete:   schema.NoAction,
+				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "favourites_users_favourites",
-				Columns:    []*schema.Column{FavouritesColumns[3]},
+				Columns:    []*schema.Column{FavouritesColumns[6]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -52,12 +174,83 @@ var (
 			{
 				Name:    "favourite_user_id_asset_id",
 				Unique:  true,
-				Columns: []*schema.Column{FavouritesColumns[3], FavouritesColumns[2]},
+				Columns: []*schema.Column{FavouritesColumns[6], FavouritesColumns[5]},
 			},
 			{
 				Name:    "favourite_user_id_created_at_id",
 				Unique:  false,
-				Columns: []*schema.Column{FavouritesColumns[3], FavouritesColumns[1], FavouritesColumns[0]},
+				Columns: []*schema.Column{FavouritesColumns[6], FavouritesColumns[2], FavouritesColumns[0]},
+			},
+			{
+				Name:    "favourite_user_id_changed_tx_id",
+				Unique:  false,
+				Columns: []*schema.Column{FavouritesColumns[6], FavouritesColumns[4], FavouritesColumns[0]},
+			},
+			{
+				Name:    "favourite_asset_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{FavouritesColumns[5], FavouritesColumns[2]},
+			},
+			{
+				Name:    "favourite_changed_tx_id",
+				Unique:  false,
+				Columns: []*schema.Column{FavouritesColumns[4], FavouritesColumns[0]},
+			},
+		},
+	}
+	// FavouriteTombstonesColumns holds the columns for the "favourite_tombstones" table.
+	FavouriteTombstonesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "asset_id", Type: field.TypeUUID},
+		{Name: "deleted_at", Type: field.TypeTime},
+		{Name: "deleted_tx", Type: field.TypeInt64, Nullable: true, Default: schema.Expr("(pg_current_xact_id()::text::bigint)")},
+	}
+	// FavouriteTombstonesTable holds the schema information for the "favourite_tombstones" table.
+	FavouriteTombstonesTable = &schema.Table{
+		Name:       "favourite_tombstones",
+		Columns:    FavouriteTombstonesColumns,
+		PrimaryKey: []*schema.Column{FavouriteTombstonesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "favouritetombstone_user_id_deleted_tx_id",
+				Unique:  false,
+				Columns: []*schema.Column{FavouriteTombstonesColumns[1], FavouriteTombstonesColumns[4], FavouriteTombstonesColumns[0]},
+			},
+			{
+				Name:    "favouritetombstone_deleted_tx_id",
+				Unique:  false,
+				Columns: []*schema.Column{FavouriteTombstonesColumns[4], FavouriteTombstonesColumns[0]},
+			},
+			{
+				Name:    "favouritetombstone_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{FavouriteTombstonesColumns[3]},
+			},
+		},
+	}
+	// OutboxEventsColumns holds the columns for the "outbox_events" table.
+	OutboxEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "available_at", Type: field.TypeTime},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "published_to", Type: field.TypeJSON, Nullable: true},
+		{Name: "published_at", Type: field.TypeTime, Nullable: true},
+	}
+	// OutboxEventsTable holds the schema information for the "outbox_events" table.
+	OutboxEventsTable = &schema.Table{
+		Name:       "outbox_events",
+		Columns:    OutboxEventsColumns,
+		PrimaryKey: []*schema.Column{OutboxEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboxevent_published_at_available_at",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[8], OutboxEventsColumns[4]},
 			},
 		},
 	}
@@ -72,15 +265,109 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "event_id", Type: field.TypeUUID},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "succeeded", "failed"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "last_status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "subscription_id", Type: field.TypeUUID},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhook_subscriptions_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[11]},
+				RefColumns: []*schema.Column{WebhookSubscriptionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[4], WebhookDeliveriesColumns[6]},
+			},
+			{
+				Name:    "webhookdelivery_subscription_id_created_at_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[11], WebhookDeliveriesColumns[10], WebhookDeliveriesColumns[0]},
+			},
+		},
+	}
+	// WebhookSubscriptionsColumns holds the columns for the "webhook_subscriptions" table.
+	WebhookSubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "url", Type: field.TypeString, Size: 2048},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "event_types", Type: field.TypeJSON},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// WebhookSubscriptionsTable holds the schema information for the "webhook_subscriptions" table.
+	WebhookSubscriptionsTable = &schema.Table{
+		Name:       "webhook_subscriptions",
+		Columns:    WebhookSubscriptionsColumns,
+		PrimaryKey: []*schema.Column{WebhookSubscriptionsColumns[0]},
+	}
+	// CollectionFavouritesColumns holds the columns for the "collection_favourites" table.
+	CollectionFavouritesColumns = []*schema.Column{
+		{Name: "collection_id", Type: field.TypeUUID},
+		{Name: "favourite_id", Type: field.TypeUUID},
+	}
+	// CollectionFavouritesTable holds the schema information for the "collection_favourites" table.
+	CollectionFavouritesTable = &schema.Table{
+		Name:       "collection_favourites",
+		Columns:    CollectionFavouritesColumns,
+		PrimaryKey: []*schema.Column{CollectionFavouritesColumns[0], CollectionFavouritesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "collection_favourites_collection_id",
+				Columns:    []*schema.Column{CollectionFavouritesColumns[0]},
+				RefColumns: []*schema.Column{CollectionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "collection_favourites_favourite_id",
+				Columns:    []*schema.Column{CollectionFavouritesColumns[1]},
+				RefColumns: []*schema.Column{FavouritesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AssetsTable,
+		AssetRevisionsTable,
+		AuditEntriesTable,
+		CollectionsTable,
 		FavouritesTable,
+		FavouriteTombstonesTable,
+		OutboxEventsTable,
 		UsersTable,
+		WebhookDeliveriesTable,
+		WebhookSubscriptionsTable,
+		CollectionFavouritesTable,
 	}
 )
 
 func init() {
+	AssetRevisionsTable.ForeignKeys[0].RefTable = AssetsTable
+	CollectionsTable.ForeignKeys[0].RefTable = UsersTable
 	FavouritesTable.ForeignKeys[0].RefTable = AssetsTable
 	FavouritesTable.ForeignKeys[1].RefTable = UsersTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhookSubscriptionsTable
+	CollectionFavouritesTable.ForeignKeys[0].RefTable = CollectionsTable
+	CollectionFavouritesTable.ForeignKeys[1].RefTable = FavouritesTable
 }
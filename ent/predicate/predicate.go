@@ -9,8 +9,29 @@ import (
 // Asset is the predicate function for asset builders.
 type Asset func(*sql.Selector)
 
+// AssetRevision is the predicate function for assetrevision builders.
+type AssetRevision func(*sql.Selector)
+
+// AuditEntry is the predicate function for auditentry builders.
+type AuditEntry func(*sql.Selector)
+
+// Collection is the predicate function for collection builders.
+type Collection func(*sql.Selector)
+
 // Favourite is the predicate function for favourite builders.
 type Favourite func(*sql.Selector)
 
+// FavouriteTombstone is the predicate function for favouritetombstone builders.
+type FavouriteTombstone func(*sql.Selector)
+
+// OutboxEvent is the predicate function for outboxevent builders.
+type OutboxEvent func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
+
+// WebhookSubscription is the predicate function for webhooksubscription builders.
+type WebhookSubscription func(*sql.Selector)
@@ -6,9 +6,16 @@ import (
 	"time"
 
 	"github.com/SokratisChaimanas/platform-go-challenge/ent/asset"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/assetrevision"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/auditentry"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/collection"
 	"github.com/SokratisChaimanas/platform-go-challenge/ent/favourite"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/favouritetombstone"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/outboxevent"
 	"github.com/SokratisChaimanas/platform-go-challenge/ent/schema"
 	"github.com/SokratisChaimanas/platform-go-challenge/ent/user"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/webhookdelivery"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/webhooksubscription"
 	"github.com/google/uuid"
 )
 
@@ -22,24 +29,100 @@ func init() {
 	assetDescDescription := assetFields[2].Descriptor()
 	// asset.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
 	asset.DescriptionValidator = assetDescDescription.Validators[0].(func(string) error)
+	// assetDescVersion is the schema descriptor for version field.
+	assetDescVersion := assetFields[4].Descriptor()
+	// asset.DefaultVersion holds the default value on creation for the version field.
+	asset.DefaultVersion = assetDescVersion.Default.(int)
+	// asset.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	asset.VersionValidator = assetDescVersion.Validators[0].(func(int) error)
 	// assetDescCreatedAt is the schema descriptor for created_at field.
-	assetDescCreatedAt := assetFields[4].Descriptor()
+	assetDescCreatedAt := assetFields[5].Descriptor()
 	// asset.DefaultCreatedAt holds the default value on creation for the created_at field.
 	asset.DefaultCreatedAt = assetDescCreatedAt.Default.(func() time.Time)
 	// assetDescID is the schema descriptor for id field.
 	assetDescID := assetFields[0].Descriptor()
 	// asset.DefaultID holds the default value on creation for the id field.
 	asset.DefaultID = assetDescID.Default.(func() uuid.UUID)
+	assetrevisionFields := schema.AssetRevision{}.Fields()
+	_ = assetrevisionFields
+	// assetrevisionDescCreatedAt is the schema descriptor for created_at field.
+	assetrevisionDescCreatedAt := assetrevisionFields[5].Descriptor()
+	// assetrevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	assetrevision.DefaultCreatedAt = assetrevisionDescCreatedAt.Default.(func() time.Time)
+	// assetrevisionDescID is the schema descriptor for id field.
+	assetrevisionDescID := assetrevisionFields[0].Descriptor()
+	// assetrevision.DefaultID holds the default value on creation for the id field.
+	assetrevision.DefaultID = assetrevisionDescID.Default.(func() uuid.UUID)
+	auditentryFields := schema.AuditEntry{}.Fields()
+	_ = auditentryFields
+	// auditentryDescCreatedAt is the schema descriptor for created_at field.
+	auditentryDescCreatedAt := auditentryFields[1].Descriptor()
+	// auditentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditentry.DefaultCreatedAt = auditentryDescCreatedAt.Default.(func() time.Time)
+	// auditentryDescRequestID is the schema descriptor for request_id field.
+	auditentryDescRequestID := auditentryFields[3].Descriptor()
+	// auditentry.DefaultRequestID holds the default value on creation for the request_id field.
+	auditentry.DefaultRequestID = auditentryDescRequestID.Default.(string)
+	// auditentryDescID is the schema descriptor for id field.
+	auditentryDescID := auditentryFields[0].Descriptor()
+	// auditentry.DefaultID holds the default value on creation for the id field.
+	auditentry.DefaultID = auditentryDescID.Default.(func() uuid.UUID)
+	collectionFields := schema.Collection{}.Fields()
+	_ = collectionFields
+	// collectionDescName is the schema descriptor for name field.
+	collectionDescName := collectionFields[2].Descriptor()
+	// collection.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	collection.NameValidator = collectionDescName.Validators[0].(func(string) error)
+	// collectionDescCreatedAt is the schema descriptor for created_at field.
+	collectionDescCreatedAt := collectionFields[3].Descriptor()
+	// collection.DefaultCreatedAt holds the default value on creation for the created_at field.
+	collection.DefaultCreatedAt = collectionDescCreatedAt.Default.(func() time.Time)
+	// collectionDescID is the schema descriptor for id field.
+	collectionDescID := collectionFields[0].Descriptor()
+	// collection.DefaultID holds the default value on creation for the id field.
+	collection.DefaultID = collectionDescID.Default.(func() uuid.UUID)
 	favouriteFields := schema.Favourite{}.Fields()
 	_ = favouriteFields
+	// favouriteDescNote is the schema descriptor for note field.
+	favouriteDescNote := favouriteFields[3].Descriptor()
+	// favourite.DefaultNote holds the default value on creation for the note field.
+	favourite.DefaultNote = favouriteDescNote.Default.(string)
 	// favouriteDescCreatedAt is the schema descriptor for created_at field.
-	favouriteDescCreatedAt := favouriteFields[3].Descriptor()
+	favouriteDescCreatedAt := favouriteFields[4].Descriptor()
 	// favourite.DefaultCreatedAt holds the default value on creation for the created_at field.
 	favourite.DefaultCreatedAt = favouriteDescCreatedAt.Default.(func() time.Time)
+	// favouriteDescChangedAt is the schema descriptor for changed_at field.
+	favouriteDescChangedAt := favouriteFields[5].Descriptor()
+	// favourite.DefaultChangedAt holds the default value on creation for the changed_at field.
+	favourite.DefaultChangedAt = favouriteDescChangedAt.Default.(func() time.Time)
 	// favouriteDescID is the schema descriptor for id field.
 	favouriteDescID := favouriteFields[0].Descriptor()
 	// favourite.DefaultID holds the default value on creation for the id field.
 	favourite.DefaultID = favouriteDescID.Default.(func() uuid.UUID)
+	favouritetombstoneFields := schema.FavouriteTombstone{}.Fields()
+	_ = favouritetombstoneFields
+	// favouritetombstoneDescDeletedAt is the schema descriptor for deleted_at field.
+	favouritetombstoneDescDeletedAt := favouritetombstoneFields[3].Descriptor()
+	// favouritetombstone.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	favouritetombstone.DefaultDeletedAt = favouritetombstoneDescDeletedAt.Default.(func() time.Time)
+	// favouritetombstoneDescID is the schema descriptor for id field.
+	favouritetombstoneDescID := favouritetombstoneFields[0].Descriptor()
+	// favouritetombstone.DefaultID holds the default value on creation for the id field.
+	favouritetombstone.DefaultID = favouritetombstoneDescID.Default.(func() uuid.UUID)
+	outboxeventFields := schema.OutboxEvent{}.Fields()
+	_ = outboxeventFields
+	// outboxeventDescCreatedAt is the schema descriptor for created_at field.
+	outboxeventDescCreatedAt := outboxeventFields[3].Descriptor()
+	// outboxevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	outboxevent.DefaultCreatedAt = outboxeventDescCreatedAt.Default.(func() time.Time)
+	// outboxeventDescAttempts is the schema descriptor for attempts field.
+	outboxeventDescAttempts := outboxeventFields[5].Descriptor()
+	// outboxevent.DefaultAttempts holds the default value on creation for the attempts field.
+	outboxevent.DefaultAttempts = outboxeventDescAttempts.Default.(int)
+	// outboxeventDescID is the schema descriptor for id field.
+	outboxeventDescID := outboxeventFields[0].Descriptor()
+	// outboxevent.DefaultID holds the default value on creation for the id field.
+	outboxevent.DefaultID = outboxeventDescID.Default.(func() uuid.UUID)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescCreatedAt is the schema descriptor for created_at field.
@@ -50,4 +133,54 @@ func init() {
 	userDescID := userFields[0].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
 	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[6].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[11].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescID is the schema descriptor for id field.
+	webhookdeliveryDescID := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.DefaultID holds the default value on creation for the id field.
+	webhookdelivery.DefaultID = webhookdeliveryDescID.Default.(func() uuid.UUID)
+	webhooksubscriptionFields := schema.WebhookSubscription{}.Fields()
+	_ = webhooksubscriptionFields
+	// webhooksubscriptionDescURL is the schema descriptor for url field.
+	webhooksubscriptionDescURL := webhooksubscriptionFields[1].Descriptor()
+	// webhooksubscription.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhooksubscription.URLValidator = func() func(string) error {
+		validators := webhooksubscriptionDescURL.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(url string) error {
+			for _, fn := range fns {
+				if err := fn(url); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhooksubscriptionDescSecret is the schema descriptor for secret field.
+	webhooksubscriptionDescSecret := webhooksubscriptionFields[2].Descriptor()
+	// webhooksubscription.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhooksubscription.SecretValidator = webhooksubscriptionDescSecret.Validators[0].(func(string) error)
+	// webhooksubscriptionDescActive is the schema descriptor for active field.
+	webhooksubscriptionDescActive := webhooksubscriptionFields[4].Descriptor()
+	// webhooksubscription.DefaultActive holds the default value on creation for the active field.
+	webhooksubscription.DefaultActive = webhooksubscriptionDescActive.Default.(bool)
+	// webhooksubscriptionDescCreatedAt is the schema descriptor for created_at field.
+	webhooksubscriptionDescCreatedAt := webhooksubscriptionFields[5].Descriptor()
+	// webhooksubscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhooksubscription.DefaultCreatedAt = webhooksubscriptionDescCreatedAt.Default.(func() time.Time)
+	// webhooksubscriptionDescID is the schema descriptor for id field.
+	webhooksubscriptionDescID := webhooksubscriptionFields[0].Descriptor()
+	// webhooksubscription.DefaultID holds the default value on creation for the id field.
+	webhooksubscription.DefaultID = webhooksubscriptionDescID.Default.(func() uuid.UUID)
 }
@@ -5,12 +5,21 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 
 	"github.com/google/uuid"
 )
 
+// AssetDescriptionSearchIndex is the GIN index backing full-text search over
+// asset descriptions. Ent indexes cannot hold expressions, so the statement is
+// applied after the ent migration (see platform/db). Queries must use the very
+// same to_tsvector expression for Postgres to pick the index.
+const AssetDescriptionSearchIndex = `CREATE INDEX IF NOT EXISTS asset_description_tsv
+	ON assets USING GIN (to_tsvector('english', description))`
+
 // Asset holds the schema definition for the Asset entity.
 type Asset struct {
 	ent.Schema
@@ -41,16 +50,41 @@ func (Asset) Fields() []ent.Field {
 				dialect.Postgres: "jsonb",
 			}),
 
+		// Optimistic concurrency: bumped on every update, exposed as ETag.
+		field.Int("version").
+			Default(1).
+			Positive(),
+
 		field.Time("created_at").
 			Default(func() time.Time {
 				return time.Now().UTC()
 			}).
 			Immutable(),
+
+		// Set when the asset is archived (soft-deleted). Archived assets are
+		// hidden from listings but keep their favourites until restored or deleted.
+		field.Time("archived_at").
+			Optional().
+			Nillable(),
 	}
 }
 
 func (Asset) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("favourites", Favourite.Type),
+		// Deleting an asset removes its favourites.
+		edge.To("favourites", Favourite.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+
+		// Description edit history goes with the asset.
+		edge.To("revisions", AssetRevision.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (Asset) Indexes() []ent.Index {
+	return []ent.Index{
+		// Catalogue keyset order and created_at range filters.
+		index.Fields("created_at", "id"),
+		index.Fields("asset_type", "created_at", "id"),
 	}
 }
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+)
+
+// AssetRevision records one description edit of an asset.
+type AssetRevision struct {
+	ent.Schema
+}
+
+func (AssetRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+
+		field.UUID("asset_id", uuid.UUID{}).
+			Immutable(),
+
+		field.String("old_description").
+			Immutable(),
+		field.String("new_description").
+			Immutable(),
+
+		// User that made the edit, when known.
+		field.UUID("editor_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable(),
+
+		field.Time("created_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			Immutable(),
+	}
+}
+
+func (AssetRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("asset", Asset.Type).
+			Ref("revisions").
+			Field("asset_id").
+			Required().
+			Unique().
+			Immutable(),
+	}
+}
+
+func (AssetRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("asset_id", "created_at", "id"),
+	}
+}
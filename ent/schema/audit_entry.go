@@ -0,0 +1,83 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+)
+
+// AuditEntry records one change to an asset or favourite: who claimed to make it, in which request,
+// and the row before and after. Entries are written by an ent hook in the transaction of the change.
+type AuditEntry struct {
+	ent.Schema
+}
+
+func (AuditEntry) Fields() []ent.Field {
+	jsonb := map[string]string{dialect.Postgres: "jsonb"}
+
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+
+		field.Time("created_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			Immutable(),
+
+		// Who the request said made the change, and in which request, when known. The actor comes from
+		// the unauthenticated X-User-ID header, so it is a claim, not a verified identity.
+		field.UUID("claimed_actor_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("request_id").
+			Default("").
+			Immutable(),
+
+		// e.g. "asset.updated"; entity_type is the part before the dot.
+		field.String("action").
+			Immutable(),
+		field.String("entity_type").
+			Immutable(),
+		field.UUID("entity_id", uuid.UUID{}).
+			Immutable(),
+
+		// Targets to filter on: the asset, and the user for favourites.
+		field.UUID("asset_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable(),
+		field.UUID("user_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable(),
+
+		// Row snapshots; before is empty for creations, after for deletions.
+		field.JSON("before", map[string]any{}).
+			Optional().
+			SchemaType(jsonb).
+			Immutable(),
+		field.JSON("after", map[string]any{}).
+			Optional().
+			SchemaType(jsonb).
+			Immutable(),
+	}
+}
+
+func (AuditEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		// Newest-first listings, unfiltered and per target, actor or request.
+		index.Fields("created_at", "id"),
+		index.Fields("asset_id", "created_at", "id"),
+		index.Fields("user_id", "created_at", "id"),
+		index.Fields("claimed_actor_id", "created_at", "id"),
+		index.Fields("request_id"),
+	}
+}
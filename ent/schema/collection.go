@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+)
+
+// Collection is a user-owned folder grouping some of the user's favourites.
+type Collection struct {
+	ent.Schema
+}
+
+func (Collection) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+
+		field.UUID("user_id", uuid.UUID{}).
+			Immutable(),
+
+		// The limit is in characters (domain.MaxCollectionNameLength), like varchar;
+		// ent's MaxLen would count bytes.
+		field.String("name").
+			NotEmpty().
+			SchemaType(map[string]string{
+				dialect.Postgres: "varchar(100)",
+			}),
+
+		field.Time("created_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			Immutable(),
+	}
+}
+
+func (Collection) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("collections").
+			Field("user_id").
+			Required().
+			Unique().
+			Immutable(),
+
+		// Many-to-many: a favourite can sit in several collections.
+		edge.To("favourites", Favourite.Type),
+	}
+}
+
+func (Collection) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "name").Unique(),
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -11,6 +13,11 @@ import (
 	"github.com/google/uuid"
 )
 
+// CurrentTxExpr is the ID of the writing transaction, as a bigint. Change feeds are ordered by it and
+// only read up to the oldest transaction still running, so a change can't commit behind a position
+// already handed out, however long its transaction takes.
+const CurrentTxExpr = "(pg_current_xact_id()::text::bigint)"
+
 // Favourite represents a "user favourites an asset" relation.
 type Favourite struct {
 	ent.Schema
@@ -25,11 +32,32 @@ func (Favourite) Fields() []ent.Field {
 		field.UUID("user_id", uuid.UUID{}),
 		field.UUID("asset_id", uuid.UUID{}),
 
+		// Private note / custom title the user attached to the favourite. The limit is in
+		// characters (domain.MaxNoteLength), like varchar; ent's MaxLen would count bytes.
+		field.String("note").
+			Default("").
+			SchemaType(map[string]string{
+				dialect.Postgres: "varchar(500)",
+			}),
+
 		field.Time("created_at").
 			Default(func() time.Time {
 				return time.Now().UTC()
 			}).
 			Immutable(),
+
+		// When the favourite last (re)appeared in the user's list: its creation, or the restore of
+		// its archived asset. Rows that predate the column get the migration time.
+		field.Time("changed_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			Annotations(entsql.Default("CURRENT_TIMESTAMP")),
+		// The transaction that set changed_at, which positions the addition in the change feed.
+		// Postgres assigns it when the row is written, so it is never set from Go.
+		field.Int64("changed_tx").
+			Optional().
+			Annotations(entsql.DefaultExpr(CurrentTxExpr)),
 	}
 }
 
@@ -46,6 +74,9 @@ func (Favourite) Edges() []ent.Edge {
 			Field("asset_id").
 			Required().
 			Unique(),
+
+		edge.From("collections", Collection.Type).
+			Ref("favourites"),
 	}
 }
 
@@ -53,5 +84,11 @@ func (Favourite) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("user_id", "asset_id").Unique(),
 		index.Fields("user_id", "created_at", "id"),
+		// Change feed additions.
+		index.Fields("user_id", "changed_tx", "id"),
+		// Per-asset counts, all time or within a trending window.
+		index.Fields("asset_id", "created_at"),
+		// The feed of all users' changes, which the recommender follows.
+		index.Fields("changed_tx", "id"),
 	}
 }
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+)
+
+// FavouriteTombstone records that a user's favourite was removed, so sync clients
+// can learn about removals. Old tombstones are pruned after the sync retention.
+type FavouriteTombstone struct {
+	ent.Schema
+}
+
+func (FavouriteTombstone) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+
+		// No edges: the asset (and favourite) may be long gone.
+		field.UUID("user_id", uuid.UUID{}).
+			Immutable(),
+		field.UUID("asset_id", uuid.UUID{}).
+			Immutable(),
+
+		field.Time("deleted_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			Immutable(),
+		// The transaction that removed the favourite, which positions the removal in the change feed.
+		field.Int64("deleted_tx").
+			Optional().
+			Immutable().
+			Annotations(entsql.DefaultExpr(CurrentTxExpr)),
+	}
+}
+
+func (FavouriteTombstone) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "deleted_tx", "id"),
+		// The feed of all users' changes, which the recommender follows.
+		index.Fields("deleted_tx", "id"),
+		// Pruning by age.
+		index.Fields("deleted_at"),
+	}
+}
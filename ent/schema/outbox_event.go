@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+)
+
+// OutboxEvent is a domain event written in the same transaction as the change that raised it.
+// The outbox relay publishes it afterwards; published events are pruned after a retention period.
+type OutboxEvent struct {
+	ent.Schema
+}
+
+func (OutboxEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+
+		field.String("event_type").
+			Immutable(),
+		field.Bytes("payload").
+			Immutable(),
+
+		field.Time("created_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			Immutable(),
+
+		// When the relay may (re)try the event: moved forward while a relay holds it and after failures.
+		field.Time("available_at"),
+		field.Int("attempts").
+			Default(0),
+		field.String("last_error").
+			Optional(),
+		// Names of the publishers that accepted the event; retries skip them.
+		field.Strings("published_to").
+			Optional(),
+		field.Time("published_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (OutboxEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		// Relay polling (published_at IS NULL) and pruning.
+		index.Fields("published_at", "available_at"),
+	}
+}
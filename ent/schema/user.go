@@ -34,5 +34,6 @@ func (User) Fields() []ent.Field {
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("favourites", Favourite.Type),
+		edge.To("collections", Collection.Type),
 	}
 }
@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/google/uuid"
+)
+
+// WebhookDelivery is one event queued for one subscription, with the state of its delivery attempts.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+
+		field.UUID("subscription_id", uuid.UUID{}).
+			Immutable(),
+
+		// The same event delivered to several subscriptions shares its event_id.
+		field.UUID("event_id", uuid.UUID{}).
+			Immutable(),
+		field.String("event_type").
+			Immutable(),
+		// Exact bytes that are posted and signed.
+		field.Bytes("payload").
+			Immutable(),
+
+		field.Enum("status").
+			Values("pending", "succeeded", "failed").
+			Default("pending"),
+		field.Int("attempts").
+			Default(0),
+		field.Time("next_attempt_at"),
+		field.Int("last_status_code").
+			Optional().
+			Nillable(),
+		field.String("last_error").
+			Optional(),
+		field.Time("delivered_at").
+			Optional().
+			Nillable(),
+
+		field.Time("created_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			Immutable(),
+	}
+}
+
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("subscription", WebhookSubscription.Type).
+			Ref("deliveries").
+			Field("subscription_id").
+			Required().
+			Unique().
+			Immutable(),
+	}
+}
+
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		// Worker polling for due deliveries.
+		index.Fields("status", "next_attempt_at"),
+		// Delivery log, newest first.
+		index.Fields("subscription_id", "created_at", "id"),
+	}
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+
+	"github.com/google/uuid"
+)
+
+// WebhookSubscription is a receiver URL that gets the events it subscribed to.
+type WebhookSubscription struct {
+	ent.Schema
+}
+
+func (WebhookSubscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+
+		field.String("url").
+			NotEmpty().
+			MaxLen(2048),
+
+		// HMAC key for the payload signature; only shown once, on creation.
+		field.String("secret").
+			NotEmpty().
+			Sensitive().
+			Immutable(),
+
+		field.Strings("event_types"),
+
+		field.Bool("active").
+			Default(true),
+
+		field.Time("created_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			Immutable(),
+	}
+}
+
+func (WebhookSubscription) Edges() []ent.Edge {
+	return []ent.Edge{
+		// The delivery log goes with the subscription.
+		edge.To("deliveries", WebhookDelivery.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
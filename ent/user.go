@@ -30,9 +30,11 @@ type User struct {
 type UserEdges struct {
 	// Favourites holds the value of the favourites edge.
 	Favourites []*Favourite `json:"favourites,omitempty"`
+	// Collections holds the value of the collections edge.
+	Collections []*Collection `json:"collections,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // FavouritesOrErr returns the Favourites value or an error if the edge
@@ -44,6 +46,15 @@ func (e UserEdges) FavouritesOrErr() ([]*Favourite, error) {
 	return nil, &NotLoadedError{edge: "favourites"}
 }
 
+// CollectionsOrErr returns the Collections value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) CollectionsOrErr() ([]*Collection, error) {
+	if e.loadedTypes[1] {
+		return e.Collections, nil
+	}
+	return nil, &NotLoadedError{edge: "collections"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -98,6 +109,11 @@ func (_m *User) QueryFavourites() *FavouriteQuery {
 	return NewUserClient(_m.config).QueryFavourites(_m)
 }
 
+// QueryCollections queries the "collections" edge of the User entity.
+func (_m *User) QueryCollections() *CollectionQuery {
+	return NewUserClient(_m.config).QueryCollections(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -0,0 +1,138 @@
+package broker
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/ports"
+	"github.com/google/uuid"
+)
+
+// subscriberBuffer is how many events a subscriber may fall behind before it is dropped.
+const subscriberBuffer = 64
+
+// Ensure ports.EventBroker interface implementation.
+var _ ports.EventBroker = (*Broker)(nil)
+
+// Broker is an in-process pub/sub for favourite events. It retains the latest events so that
+// reconnecting subscribers can resume from their last event ID; retained events do not survive
+// a restart. Event IDs start from the process start time in microseconds, so they keep
+// increasing across restarts and a stale ID is detected as a gap rather than misread.
+type Broker struct {
+	mu       sync.Mutex
+	retain   int
+	firstID  uint64 // ID of the first event this process publishes
+	nextID   uint64
+	retained []domain.FavouriteEvent // oldest first, at least the last retain events
+	subs     map[uuid.UUID]map[chan domain.FavouriteEvent]struct{}
+	closed   bool
+}
+
+// NewBroker returns a broker that retains the last retain events for replay.
+func NewBroker(retain int) *Broker {
+	start := uint64(time.Now().UnixMicro())
+	return &Broker{
+		retain:  retain,
+		firstID: start,
+		nextID:  start,
+		subs:    make(map[uuid.UUID]map[chan domain.FavouriteEvent]struct{}),
+	}
+}
+
+// Publish assigns the event its ID, retains it and hands it to the user's subscribers.
+// A subscriber whose buffer is full is dropped instead of blocking the publisher.
+func (broker *Broker) Publish(event domain.FavouriteEvent) {
+	broker.mu.Lock()
+	defer broker.mu.Unlock()
+
+	if broker.closed {
+		return
+	}
+
+	event.ID = broker.nextID
+	broker.nextID++
+
+	broker.retained = append(broker.retained, event)
+	// Trim in bulk so publishing stays amortised O(1).
+	if len(broker.retained) >= 2*broker.retain {
+		broker.retained = slices.Clone(broker.retained[len(broker.retained)-broker.retain:])
+	}
+
+	for ch := range broker.subs[event.UserID] {
+		select {
+		case ch <- event:
+		default:
+			broker.drop(event.UserID, ch)
+		}
+	}
+}
+
+// Subscribe registers a subscriber for the user and collects the retained events after lastEventID.
+func (broker *Broker) Subscribe(userID uuid.UUID, lastEventID uint64) (ports.EventSubscription, error) {
+	broker.mu.Lock()
+	defer broker.mu.Unlock()
+
+	if broker.closed {
+		return ports.EventSubscription{}, domain.ErrEventsClosed
+	}
+
+	var sub ports.EventSubscription
+	if lastEventID != 0 {
+		oldest := broker.firstID
+		if len(broker.retained) > 0 {
+			oldest = broker.retained[0].ID
+		}
+		// An ID before the retained window, or one this process never issued, may hide lost events.
+		sub.Gap = lastEventID+1 < oldest || lastEventID >= broker.nextID
+
+		for _, event := range broker.retained {
+			if event.ID > lastEventID && event.UserID == userID {
+				sub.Missed = append(sub.Missed, event)
+			}
+		}
+	}
+
+	ch := make(chan domain.FavouriteEvent, subscriberBuffer)
+	if broker.subs[userID] == nil {
+		broker.subs[userID] = make(map[chan domain.FavouriteEvent]struct{})
+	}
+	broker.subs[userID][ch] = struct{}{}
+
+	sub.Events = ch
+	sub.Cancel = func() {
+		broker.mu.Lock()
+		defer broker.mu.Unlock()
+		broker.drop(userID, ch)
+	}
+	return sub, nil
+}
+
+// Close ends every subscription and rejects new ones. It is meant to run on server shutdown so
+// that open streams return instead of holding the shutdown up.
+func (broker *Broker) Close() {
+	broker.mu.Lock()
+	defer broker.mu.Unlock()
+
+	broker.closed = true
+	for userID, chans := range broker.subs {
+		for ch := range chans {
+			close(ch)
+		}
+		delete(broker.subs, userID)
+	}
+}
+
+// drop unregisters and closes a subscriber channel if it is still registered. Callers hold mu.
+func (broker *Broker) drop(userID uuid.UUID, ch chan domain.FavouriteEvent) {
+	chans := broker.subs[userID]
+	if _, ok := chans[ch]; !ok {
+		return
+	}
+	delete(chans, ch)
+	close(ch)
+	if len(chans) == 0 {
+		delete(broker.subs, userID)
+	}
+}
@@ -0,0 +1,98 @@
+package broker
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/ports"
+	"github.com/google/uuid"
+)
+
+func TestSubscribeReplaysRetainedEvents(t *testing.T) {
+	alice, bob := uuid.New(), uuid.New()
+	broker := NewBroker(2)
+
+	first := subscribe(t, broker, alice, 0)
+	broker.Publish(domain.FavouriteEvent{UserID: alice, Kind: domain.ChangeAdded})
+	broker.Publish(domain.FavouriteEvent{UserID: bob, Kind: domain.ChangeAdded})
+	broker.Publish(domain.FavouriteEvent{UserID: alice, Kind: domain.ChangeRemoved})
+
+	got := <-first.Events
+	if got.Kind != domain.ChangeAdded {
+		t.Fatalf("first event = %+v, want the addition", got)
+	}
+	last := (<-first.Events).ID
+
+	tests := []struct {
+		name       string
+		after      uint64
+		wantMissed int
+		wantGap    bool
+	}{
+		{name: "fresh", after: 0},
+		{name: "resumes after the first event", after: got.ID, wantMissed: 1},
+		{name: "up to date", after: last},
+		{name: "before the retained window", after: got.ID - 2, wantMissed: 2, wantGap: true},
+		{name: "never issued", after: last + 1, wantGap: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sub := subscribe(t, broker, alice, tt.after)
+			if len(sub.Missed) != tt.wantMissed || sub.Gap != tt.wantGap {
+				t.Fatalf("Subscribe(%d) missed %d events (gap %v), want %d (gap %v)",
+					tt.after, len(sub.Missed), sub.Gap, tt.wantMissed, tt.wantGap)
+			}
+			for _, event := range sub.Missed {
+				if event.UserID != alice || event.ID <= tt.after {
+					t.Fatalf("Subscribe(%d) replayed %+v", tt.after, event)
+				}
+			}
+		})
+	}
+}
+
+func TestSlowSubscribersAreDropped(t *testing.T) {
+	user := uuid.New()
+	broker := NewBroker(10)
+	sub := subscribe(t, broker, user, 0)
+
+	for range subscriberBuffer + 1 {
+		broker.Publish(domain.FavouriteEvent{UserID: user})
+	}
+
+	n := 0
+	for range sub.Events {
+		n++
+	}
+	if n != subscriberBuffer {
+		t.Fatalf("dropped subscriber received %d events, want %d", n, subscriberBuffer)
+	}
+	sub.Cancel() // already dropped: a no-op
+}
+
+func TestCloseEndsSubscriptions(t *testing.T) {
+	user := uuid.New()
+	broker := NewBroker(10)
+	sub := subscribe(t, broker, user, 0)
+
+	broker.Close()
+	if _, ok := <-sub.Events; ok {
+		t.Fatal("Events still open after Close()")
+	}
+	if _, err := broker.Subscribe(user, 0); !errors.Is(err, domain.ErrEventsClosed) {
+		t.Fatalf("Subscribe() after Close() error = %v, want %v", err, domain.ErrEventsClosed)
+	}
+	broker.Publish(domain.FavouriteEvent{UserID: user}) // ignored after Close
+}
+
+func subscribe(t *testing.T, broker *Broker, userID uuid.UUID, lastEventID uint64) ports.EventSubscription {
+	t.Helper()
+	s, err := broker.Subscribe(userID, lastEventID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(s.Cancel)
+	return s
+}
@@ -0,0 +1,108 @@
+package entadapter
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/ent"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+)
+
+// The records below define how each typed payload is stored in the
+// assets.payload JSONB column. Keys match the historical free-form payloads.
+
+type chartPayloadRecord struct {
+	Title  string    `json:"title"`
+	X      []string  `json:"x,omitempty"`
+	Y      []float64 `json:"y,omitempty"`
+	Series []string  `json:"series,omitempty"`
+	Values []float64 `json:"values,omitempty"`
+	YLabel string    `json:"y_label,omitempty"`
+}
+
+type insightPayloadRecord struct {
+	Text string `json:"text"`
+}
+
+type audiencePayloadRecord struct {
+	Gender         string `json:"gender,omitempty"`
+	AgeGroup       string `json:"age_group,omitempty"`
+	Country        string `json:"country,omitempty"`
+	SocialHours    string `json:"social_hours,omitempty"`
+	PurchasesMonth string `json:"purchases_month,omitempty"`
+}
+
+// encodePayload converts a typed domain payload into the JSONB map stored by ent.
+func encodePayload(payload domain.AssetPayload) (map[string]any, error) {
+	var record any
+	switch p := payload.(type) {
+	case domain.ChartPayload:
+		record = chartPayloadRecord(p)
+	case domain.InsightPayload:
+		record = insightPayloadRecord(p)
+	case domain.AudiencePayload:
+		record = audiencePayloadRecord(p)
+	default:
+		return nil, fmt.Errorf("%w: unsupported payload %T", domain.ErrInvalidPayload, payload)
+	}
+
+	b, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+	out := map[string]any{}
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// decodePayload converts a stored JSONB map into the typed payload for assetType.
+func decodePayload(assetType domain.AssetType, raw map[string]any) (domain.AssetPayload, error) {
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	switch assetType {
+	case domain.AssetTypeChart:
+		var r chartPayloadRecord
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, fmt.Errorf("%w: %v", domain.ErrInvalidPayload, err)
+		}
+		return domain.ChartPayload(r), nil
+	case domain.AssetTypeInsight:
+		var r insightPayloadRecord
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, fmt.Errorf("%w: %v", domain.ErrInvalidPayload, err)
+		}
+		return domain.InsightPayload(r), nil
+	case domain.AssetTypeAudience:
+		var r audiencePayloadRecord
+		if err := json.Unmarshal(b, &r); err != nil {
+			return nil, fmt.Errorf("%w: %v", domain.ErrInvalidPayload, err)
+		}
+		return domain.AudiencePayload(r), nil
+	default:
+		return nil, domain.ErrInvalidAssetType
+	}
+}
+
+// toDomainAsset maps an ent asset to the domain model, decoding its payload.
+func toDomainAsset(a *ent.Asset) (*domain.Asset, error) {
+	assetType := domain.AssetType(a.AssetType)
+	payload, err := decodePayload(assetType, a.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("decode payload of asset %s: %w", a.ID, err)
+	}
+
+	return &domain.Asset{
+		ID:          a.ID,
+		Type:        assetType,
+		Description: a.Description,
+		Payload:     payload,
+		Version:     a.Version,
+		CreatedAt:   a.CreatedAt,
+		ArchivedAt:  a.ArchivedAt,
+	}, nil
+}
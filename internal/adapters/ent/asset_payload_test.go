@@ -0,0 +1,31 @@
+package entadapter
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+)
+
+func TestPayloadRoundTrip(t *testing.T) {
+	payloads := []domain.AssetPayload{
+		domain.ChartPayload{Title: "DAU", X: []string{"Mon", "Tue"}, Y: []float64{120, 150.5}, YLabel: "users"},
+		domain.ChartPayload{Title: "Channels", Series: []string{"Email", "Ads"}, Values: []float64{2.1, 1.3}},
+		domain.InsightPayload{Text: "40% of millennials spend more than 3 hours on social media daily"},
+		domain.AudiencePayload{Gender: "Male", AgeGroup: "24-35", Country: "US"},
+	}
+
+	for _, payload := range payloads {
+		raw, err := encodePayload(payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := decodePayload(payload.AssetType(), raw)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, payload) {
+			t.Fatalf("decodePayload(encodePayload(%+v)) = %+v", payload, got)
+		}
+	}
+}
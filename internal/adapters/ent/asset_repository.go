@@ -2,8 +2,14 @@ package entadapter
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/SokratisChaimanas/platform-go-challenge/ent"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/asset"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/favourite"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/predicate"
 	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
 	"github.com/SokratisChaimanas/platform-go-challenge/internal/ports"
 	"github.com/google/uuid"
@@ -13,15 +19,26 @@ import (
 var _ ports.AssetRepository = (*AssetRepo)(nil)
 
 type AssetRepo struct {
-	client *ent.Client
+	client  *ent.Client
+	cursors *CursorCodec
 }
 
-func NewAssetRepo(client *ent.Client) *AssetRepo {
-	return &AssetRepo{client: client}
+func NewAssetRepo(client *ent.Client, cursors *CursorCodec) *AssetRepo {
+	return &AssetRepo{client: client, cursors: cursors}
 }
 
 func (assetRepo *AssetRepo) Get(ctx context.Context, id uuid.UUID) (*domain.Asset, error) {
-	a, err := assetRepo.client.Asset.Get(ctx, id)
+	return assetRepo.getOne(ctx, clientFrom(ctx, assetRepo.client).Asset.Query().Where(asset.ID(id)))
+}
+
+// GetForShare reads the asset with FOR SHARE: an archive, edit or delete of the row waits for the
+// transaction carried by ctx, which in turn waits for such a change in flight and sees its result.
+func (assetRepo *AssetRepo) GetForShare(ctx context.Context, id uuid.UUID) (*domain.Asset, error) {
+	return assetRepo.getOne(ctx, clientFrom(ctx, assetRepo.client).Asset.Query().Where(asset.ID(id)).ForShare())
+}
+
+func (assetRepo *AssetRepo) getOne(ctx context.Context, query *ent.AssetQuery) (*domain.Asset, error) {
+	a, err := query.Only(ctx)
 
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -30,26 +47,232 @@ func (assetRepo *AssetRepo) Get(ctx context.Context, id uuid.UUID) (*domain.Asse
 		return nil, err
 	}
 
-	return &domain.Asset{
-		ID:          a.ID,
-		Type:        domain.AssetType(a.AssetType),
-		Description: a.Description,
-		Payload:     a.Payload,
-	}, nil
+	return toDomainAsset(a)
+}
+
+func (assetRepo *AssetRepo) GetMany(ctx context.Context, ids []uuid.UUID) ([]domain.Asset, error) {
+	return assetRepo.getMany(ctx, clientFrom(ctx, assetRepo.client).Asset.Query().Where(asset.IDIn(ids...)))
+}
+
+// GetManyForShare reads the assets with FOR SHARE, like GetForShare.
+func (assetRepo *AssetRepo) GetManyForShare(ctx context.Context, ids []uuid.UUID) ([]domain.Asset, error) {
+	return assetRepo.getMany(ctx, clientFrom(ctx, assetRepo.client).Asset.Query().Where(asset.IDIn(ids...)).ForShare())
+}
+
+func (assetRepo *AssetRepo) getMany(ctx context.Context, query *ent.AssetQuery) ([]domain.Asset, error) {
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	assets := make([]domain.Asset, 0, len(rows))
+	for _, row := range rows {
+		a, err := toDomainAsset(row)
+		if err != nil {
+			return nil, err
+		}
+		assets = append(assets, *a)
+	}
+	return assets, nil
+}
+
+// Create inserts a new asset and copies the generated ID back to the domain model.
+// It runs in a transaction so the audit entry commits with the asset.
+func (assetRepo *AssetRepo) Create(ctx context.Context, assetToCreate *domain.Asset) error {
+	payload, err := encodePayload(assetToCreate.Payload)
+	if err != nil {
+		return err
+	}
+
+	var created *ent.Asset
+	err = inTx(ctx, assetRepo.client, func(ctx context.Context) error {
+		created, err = clientFrom(ctx, assetRepo.client).Asset.
+			Create().
+			SetAssetType(asset.AssetType(assetToCreate.Type)).
+			SetDescription(assetToCreate.Description).
+			SetPayload(payload).
+			Save(ctx)
+		return err
+	})
+
+	if err != nil {
+		// Only the asset type is mapped; other validation failures are not the client's type.
+		var validationErr *ent.ValidationError
+		if errors.As(err, &validationErr) && validationErr.Name == asset.FieldAssetType {
+			return fmt.Errorf("%w: %v", domain.ErrInvalidAssetType, err)
+		}
+		return err
+	}
+
+	assetToCreate.ID = created.ID
+	assetToCreate.Version = created.Version
+	assetToCreate.CreatedAt = created.CreatedAt
+	return nil
 }
 
-// Update persists changes from the domain model.
-// It does not update immutable fields.
+// Update persists changes from the domain model (description, payload, archive state).
+// It does not update immutable fields. The write only succeeds if the stored version
+// still equals updatedAsset.Version (domain.ErrAssetVersionMismatch otherwise);
+// on success the version is bumped and copied back to the domain model.
+// It runs in a transaction so the audit entry commits with the change.
 func (assetRepo *AssetRepo) Update(ctx context.Context, updatedAsset *domain.Asset) error {
-	_, err := assetRepo.client.Asset.
-		UpdateOneID(updatedAsset.ID).
-		SetDescription(updatedAsset.Description).
-		SetPayload(updatedAsset.Payload).
-		Save(ctx)
+	payload, err := encodePayload(updatedAsset.Payload)
+	if err != nil {
+		return err
+	}
+
+	var saved *ent.Asset
+	err = inTx(ctx, assetRepo.client, func(ctx context.Context) error {
+		client := clientFrom(ctx, assetRepo.client)
+		// Read under the same version condition, so it is the state the update replaces.
+		prev, err := client.Asset.
+			Query().
+			Where(asset.ID(updatedAsset.ID), asset.Version(updatedAsset.Version)).
+			Select(asset.FieldArchivedAt).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
 
-	if ent.IsNotFound(err) {
+		upd := client.Asset.
+			UpdateOneID(updatedAsset.ID).
+			Where(asset.Version(updatedAsset.Version)).
+			AddVersion(1).
+			SetDescription(updatedAsset.Description).
+			SetPayload(payload)
+
+		if updatedAsset.ArchivedAt != nil {
+			upd = upd.SetArchivedAt(*updatedAsset.ArchivedAt)
+		} else {
+			upd = upd.ClearArchivedAt()
+		}
+
+		if saved, err = upd.Save(ctx); err != nil {
+			return err
+		}
+		return recordArchiveChange(ctx, client, saved.ID, prev.ArchivedAt != nil, saved.ArchivedAt != nil)
+	})
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			return err
+		}
+		// Either the asset is gone or its version moved on.
+		exists, existsErr := clientFrom(ctx, assetRepo.client).Asset.Query().Where(asset.ID(updatedAsset.ID)).Exist(ctx)
+		if existsErr != nil {
+			return existsErr
+		}
+		if exists {
+			return domain.ErrAssetVersionMismatch
+		}
 		return domain.ErrAssetNotFound
 	}
 
-	return err
+	updatedAsset.Version = saved.Version
+	return nil
+}
+
+// Delete removes an asset. Its favourites are removed by the ON DELETE CASCADE foreign key.
+func (assetRepo *AssetRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	return inTx(ctx, assetRepo.client, func(ctx context.Context) error {
+		client := clientFrom(ctx, assetRepo.client)
+		// The database cascade would drop the favourites silently; remove them first so
+		// their users' change feeds see the removals.
+		if _, err := deleteWithTombstones(ctx, client, favourite.AssetID(id)); err != nil {
+			return err
+		}
+
+		err := client.Asset.DeleteOneID(id).Exec(ctx)
+		if ent.IsNotFound(err) {
+			return domain.ErrAssetNotFound
+		}
+		return err
+	})
+}
+
+// ListKeyset returns non-archived assets matching filter using keyset pagination.
+func (assetRepo *AssetRepo) ListKeyset(
+	ctx context.Context, filter ports.AssetFilter, limit int, after string,
+) ([]domain.Asset, *string, error) {
+	limit = boundLimit(limit)
+
+	q := clientFrom(ctx, assetRepo.client).Asset.
+		Query().
+		Where(asset.ArchivedAtIsNil()).
+		// Deterministic total order: created_at ASC, id ASC
+		Order(
+			asset.ByCreatedAt(sql.OrderAsc()),
+			asset.ByID(sql.OrderAsc()),
+		)
+
+	if filter.Type != "" {
+		q = q.Where(asset.AssetTypeEQ(asset.AssetType(filter.Type)))
+	}
+	if filter.CreatedFrom != nil {
+		q = q.Where(asset.CreatedAtGTE(*filter.CreatedFrom))
+	}
+	if filter.CreatedTo != nil {
+		q = q.Where(asset.CreatedAtLT(*filter.CreatedTo))
+	}
+	if filter.Query != "" {
+		q = q.Where(descriptionMatches(filter.Query))
+	}
+
+	// Seek to > (created_at,id) if a cursor was provided.
+	if after != "" {
+		cur, err := assetRepo.cursors.decode(after, assetsScope)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", domain.ErrBadCursor, err)
+		}
+		q = q.Where(
+			asset.Or(
+				asset.CreatedAtGT(cur.T),
+				asset.And(
+					asset.CreatedAtEQ(cur.T),
+					asset.IDGT(cur.I),
+				),
+			),
+		)
+	}
+
+	// Pull one extra to know if there's another page.
+	rows, err := q.Limit(limit + 1).All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nextAfter *string
+	if len(rows) > limit {
+		last := rows[limit-1]
+		cstr, err := assetRepo.cursors.encode(ksCursor{T: last.CreatedAt, I: last.ID}, assetsScope)
+		if err != nil {
+			return nil, nil, err
+		}
+		nextAfter = &cstr
+		rows = rows[:limit]
+	}
+
+	assets := make([]domain.Asset, 0, len(rows))
+	for _, row := range rows {
+		a, err := toDomainAsset(row)
+		if err != nil {
+			return nil, nil, err
+		}
+		assets = append(assets, *a)
+	}
+
+	return assets, nextAfter, nil
+}
+
+// descriptionMatches is a full-text predicate over the asset description.
+// The to_tsvector expression mirrors schema.AssetDescriptionSearchIndex so the GIN index is used.
+func descriptionMatches(query string) predicate.Asset {
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString("to_tsvector('english', ").
+				Ident(s.C(asset.FieldDescription)).
+				WriteString(") @@ plainto_tsquery('english', ").
+				Arg(query).
+				WriteString(")")
+		}))
+	}
 }
@@ -0,0 +1,135 @@
+package entadapter
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/assetrevision"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/ports"
+	"github.com/google/uuid"
+)
+
+// Compile time safety for ports.AssetRevisionRepository implementation.
+var _ ports.AssetRevisionRepository = (*AssetRevisionRepo)(nil)
+
+// AssetRevisionRepo implements ports.AssetRevisionRepository using Ent.
+type AssetRevisionRepo struct {
+	client  *ent.Client
+	cursors *CursorCodec
+}
+
+func NewAssetRevisionRepo(client *ent.Client, cursors *CursorCodec) *AssetRevisionRepo {
+	return &AssetRevisionRepo{client: client, cursors: cursors}
+}
+
+// Create inserts a revision and copies the generated ID back to the domain model.
+func (revisionRepo *AssetRevisionRepo) Create(ctx context.Context, revisionToCreate *domain.AssetRevision) error {
+	created, err := clientFrom(ctx, revisionRepo.client).AssetRevision.
+		Create().
+		SetAssetID(revisionToCreate.AssetID).
+		SetOldDescription(revisionToCreate.OldDescription).
+		SetNewDescription(revisionToCreate.NewDescription).
+		SetNillableEditorID(revisionToCreate.EditorID).
+		SetCreatedAt(revisionToCreate.CreatedAt).
+		Save(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	revisionToCreate.ID = created.ID
+	return nil
+}
+
+// Get returns the revision with the given id if it belongs to assetID.
+func (revisionRepo *AssetRevisionRepo) Get(ctx context.Context, assetID, revisionID uuid.UUID) (*domain.AssetRevision, error) {
+	r, err := clientFrom(ctx, revisionRepo.client).AssetRevision.
+		Query().
+		Where(
+			assetrevision.ID(revisionID),
+			assetrevision.AssetID(assetID),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrRevisionNotFound
+		}
+		return nil, err
+	}
+
+	rev := toDomainRevision(r)
+	return &rev, nil
+}
+
+// ListByAssetKeyset returns an asset's revisions newest first using keyset pagination.
+func (revisionRepo *AssetRevisionRepo) ListByAssetKeyset(
+	ctx context.Context, assetID uuid.UUID, limit int, after string,
+) ([]domain.AssetRevision, *string, error) {
+	limit = boundLimit(limit)
+
+	q := clientFrom(ctx, revisionRepo.client).AssetRevision.
+		Query().
+		Where(assetrevision.AssetID(assetID)).
+		// Deterministic total order, newest first: created_at DESC, id DESC
+		Order(
+			assetrevision.ByCreatedAt(sql.OrderDesc()),
+			assetrevision.ByID(sql.OrderDesc()),
+		)
+
+	// Seek to < (created_at,id) if a cursor was provided.
+	if after != "" {
+		cur, err := revisionRepo.cursors.decode(after, revisionsScope(assetID))
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", domain.ErrBadCursor, err)
+		}
+		q = q.Where(
+			assetrevision.Or(
+				assetrevision.CreatedAtLT(cur.T),
+				assetrevision.And(
+					assetrevision.CreatedAtEQ(cur.T),
+					assetrevision.IDLT(cur.I),
+				),
+			),
+		)
+	}
+
+	// Pull one extra to know if there's another page.
+	rows, err := q.Limit(limit + 1).All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nextAfter *string
+	if len(rows) > limit {
+		last := rows[limit-1]
+		cstr, err := revisionRepo.cursors.encode(ksCursor{T: last.CreatedAt, I: last.ID}, revisionsScope(assetID))
+		if err != nil {
+			return nil, nil, err
+		}
+		nextAfter = &cstr
+		rows = rows[:limit]
+	}
+
+	revisions := make([]domain.AssetRevision, 0, len(rows))
+	for _, r := range rows {
+		revisions = append(revisions, toDomainRevision(r))
+	}
+
+	return revisions, nextAfter, nil
+}
+
+// toDomainRevision maps an ent revision to the domain model.
+func toDomainRevision(r *ent.AssetRevision) domain.AssetRevision {
+	return domain.AssetRevision{
+		ID:             r.ID,
+		AssetID:        r.AssetID,
+		OldDescription: r.OldDescription,
+		NewDescription: r.NewDescription,
+		EditorID:       r.EditorID,
+		CreatedAt:      r.CreatedAt,
+	}
+}
@@ -0,0 +1,258 @@
+package entadapter
+
+import (
+	"context"
+	"maps"
+	"reflect"
+	"unicode/utf8"
+
+	entgo "entgo.io/ent"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/asset"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/favourite"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/google/uuid"
+)
+
+// UseAuditLog registers the hooks that record an audit entry for every asset and favourite
+// creation, update and deletion. Entries are written with the mutation's own client, so they
+// commit or roll back with the change; repositories run these mutations in a transaction.
+func UseAuditLog(client *ent.Client) {
+	client.Asset.Use(assetAudit.hook)
+	client.Favourite.Use(favouriteAudit.hook)
+}
+
+// auditedMutation is what the audit hook needs of a generated mutation.
+type auditedMutation interface {
+	ent.Mutation
+	IDs(ctx context.Context) ([]uuid.UUID, error)
+	Client() *ent.Client
+}
+
+// auditRow is the snapshot of one audited entity.
+type auditRow struct {
+	id       uuid.UUID
+	assetID  *uuid.UUID
+	userID   *uuid.UUID
+	snapshot map[string]any
+	// private is compared between before and after but never stored.
+	private string
+}
+
+// auditSpec describes how one entity type is audited.
+type auditSpec struct {
+	entityType                string
+	created, updated, deleted domain.AuditAction
+
+	// row snapshots the entity returned by a create or update-one mutation.
+	row func(v ent.Value) (auditRow, bool)
+	// load snapshots the entities with the given IDs.
+	load func(ctx context.Context, client *ent.Client, ids []uuid.UUID) ([]auditRow, error)
+	// privateChanged is the after snapshot key flagging an update of the row's private data.
+	privateChanged string
+}
+
+var assetAudit = auditSpec{
+	entityType: domain.AuditEntityAsset,
+	created:    domain.AuditAssetCreated,
+	updated:    domain.AuditAssetUpdated,
+	deleted:    domain.AuditAssetDeleted,
+	row: func(v ent.Value) (auditRow, bool) {
+		a, ok := v.(*ent.Asset)
+		if !ok {
+			return auditRow{}, false
+		}
+		return assetAuditRow(a), true
+	},
+	load: func(ctx context.Context, client *ent.Client, ids []uuid.UUID) ([]auditRow, error) {
+		assets, err := client.Asset.Query().Where(asset.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows := make([]auditRow, 0, len(assets))
+		for _, a := range assets {
+			rows = append(rows, assetAuditRow(a))
+		}
+		return rows, nil
+	},
+}
+
+var favouriteAudit = auditSpec{
+	entityType: domain.AuditEntityFavourite,
+	created:    domain.AuditFavouriteCreated,
+	updated:    domain.AuditFavouriteUpdated,
+	deleted:    domain.AuditFavouriteDeleted,
+	// The note is private to its user: snapshots hold its length, and edits are flagged.
+	privateChanged: "note_changed",
+	row: func(v ent.Value) (auditRow, bool) {
+		f, ok := v.(*ent.Favourite)
+		if !ok {
+			return auditRow{}, false
+		}
+		return favouriteAuditRow(f), true
+	},
+	load: func(ctx context.Context, client *ent.Client, ids []uuid.UUID) ([]auditRow, error) {
+		favs, err := client.Favourite.Query().Where(favourite.IDIn(ids...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows := make([]auditRow, 0, len(favs))
+		for _, f := range favs {
+			rows = append(rows, favouriteAuditRow(f))
+		}
+		return rows, nil
+	},
+}
+
+func assetAuditRow(a *ent.Asset) auditRow {
+	return auditRow{
+		id:      a.ID,
+		assetID: &a.ID,
+		snapshot: map[string]any{
+			"id":          a.ID,
+			"asset_type":  a.AssetType,
+			"description": a.Description,
+			"payload":     a.Payload,
+			"version":     a.Version,
+			"created_at":  a.CreatedAt,
+			"archived_at": a.ArchivedAt,
+		},
+	}
+}
+
+func favouriteAuditRow(f *ent.Favourite) auditRow {
+	return auditRow{
+		id:      f.ID,
+		assetID: &f.AssetID,
+		userID:  &f.UserID,
+		snapshot: map[string]any{
+			"id":          f.ID,
+			"user_id":     f.UserID,
+			"asset_id":    f.AssetID,
+			"note_length": utf8.RuneCountInString(f.Note),
+			"created_at":  f.CreatedAt,
+		},
+		private: f.Note,
+	}
+}
+
+// hook snapshots the affected entities around the mutation and records one entry per entity.
+// Updates that leave the snapshot as it was (edges such as collection memberships, or a favourite's
+// change-feed position) are not recorded.
+func (spec auditSpec) hook(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		am, ok := m.(auditedMutation)
+		if !ok {
+			return next.Mutate(ctx, m)
+		}
+
+		op := m.Op()
+		if op.Is(entgo.OpCreate) {
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			after, ok := spec.row(v)
+			if !ok {
+				return v, nil
+			}
+			return v, spec.record(ctx, am, spec.created, []auditRow{after})
+		}
+
+		isUpdate := op.Is(entgo.OpUpdate | entgo.OpUpdateOne)
+		if isUpdate && len(m.Fields())+len(m.AddedFields())+len(m.ClearedFields()) == 0 {
+			return next.Mutate(ctx, m)
+		}
+
+		ids, err := am.IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		before, err := spec.load(ctx, am.Client(), ids)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return v, err
+		}
+		if !isUpdate {
+			return v, spec.record(ctx, am, spec.deleted, before)
+		}
+
+		var after []auditRow
+		if row, ok := spec.row(v); ok {
+			after = []auditRow{row}
+		} else if after, err = spec.load(ctx, am.Client(), ids); err != nil {
+			return nil, err
+		}
+		return v, spec.recordUpdates(ctx, am, before, after)
+	})
+}
+
+// recordUpdates pairs the before and after snapshots by ID and records the entities present in both
+// that changed. A change to private data is flagged in the after snapshot under spec.privateChanged.
+func (spec auditSpec) recordUpdates(ctx context.Context, am auditedMutation, before, after []auditRow) error {
+	old := make(map[uuid.UUID]auditRow, len(before))
+	for _, row := range before {
+		old[row.id] = row
+	}
+
+	creates := make([]*ent.AuditEntryCreate, 0, len(after))
+	for _, row := range after {
+		prev, ok := old[row.id]
+		if !ok {
+			continue
+		}
+		privateChanged := prev.private != row.private
+		if !privateChanged && reflect.DeepEqual(prev.snapshot, row.snapshot) {
+			continue
+		}
+		if privateChanged {
+			row.snapshot = maps.Clone(row.snapshot)
+			row.snapshot[spec.privateChanged] = true
+		}
+		creates = append(creates, spec.entry(ctx, am.Client(), spec.updated, row).
+			SetBefore(prev.snapshot).
+			SetAfter(row.snapshot))
+	}
+	return saveAuditEntries(ctx, am.Client(), creates)
+}
+
+// record writes one entry per row: the rows are the after snapshots of creations
+// and the before snapshots of deletions.
+func (spec auditSpec) record(ctx context.Context, am auditedMutation, action domain.AuditAction, rows []auditRow) error {
+	creates := make([]*ent.AuditEntryCreate, 0, len(rows))
+	for _, row := range rows {
+		c := spec.entry(ctx, am.Client(), action, row)
+		if action == spec.created {
+			c.SetAfter(row.snapshot)
+		} else {
+			c.SetBefore(row.snapshot)
+		}
+		creates = append(creates, c)
+	}
+	return saveAuditEntries(ctx, am.Client(), creates)
+}
+
+// entry starts an audit entry for row with the actor and request ID carried by ctx.
+func (spec auditSpec) entry(ctx context.Context, client *ent.Client, action domain.AuditAction, row auditRow) *ent.AuditEntryCreate {
+	ac := domain.AuditContextFrom(ctx)
+	return client.AuditEntry.
+		Create().
+		SetNillableClaimedActorID(ac.ClaimedActorID).
+		SetRequestID(ac.RequestID).
+		SetAction(string(action)).
+		SetEntityType(spec.entityType).
+		SetEntityID(row.id).
+		SetNillableAssetID(row.assetID).
+		SetNillableUserID(row.userID)
+}
+
+func saveAuditEntries(ctx context.Context, client *ent.Client, creates []*ent.AuditEntryCreate) error {
+	if len(creates) == 0 {
+		return nil
+	}
+	return client.AuditEntry.CreateBulk(creates...).Exec(ctx)
+}
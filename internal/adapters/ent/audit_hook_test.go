@@ -0,0 +1,25 @@
+package entadapter
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/ent"
+	"github.com/google/uuid"
+)
+
+func TestFavouriteAuditRowKeepsTheNotePrivate(t *testing.T) {
+	const note = "Λίστα για την Παρασκευή"
+	row := favouriteAuditRow(&ent.Favourite{ID: uuid.New(), UserID: uuid.New(), AssetID: uuid.New(), Note: note})
+
+	if got := fmt.Sprint(row.snapshot); strings.Contains(got, note) {
+		t.Fatalf("snapshot %s holds the note", got)
+	}
+	if got := row.snapshot["note_length"]; got != 23 {
+		t.Fatalf("note_length = %v, want 23", got)
+	}
+	if row.private != note {
+		t.Fatalf("private = %q, want the note to compare edits", row.private)
+	}
+}
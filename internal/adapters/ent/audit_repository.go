@@ -0,0 +1,119 @@
+package entadapter
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/auditentry"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/ports"
+)
+
+// Compile time safety for ports.AuditRepository implementation.
+var _ ports.AuditRepository = (*AuditRepo)(nil)
+
+// AuditRepo implements ports.AuditRepository using Ent. Entries are written by the hooks of UseAuditLog.
+type AuditRepo struct {
+	client  *ent.Client
+	cursors *CursorCodec
+}
+
+func NewAuditRepo(client *ent.Client, cursors *CursorCodec) *AuditRepo {
+	return &AuditRepo{client: client, cursors: cursors}
+}
+
+// ListKeyset returns entries matching filter newest first using keyset pagination.
+func (auditRepo *AuditRepo) ListKeyset(
+	ctx context.Context, filter domain.AuditFilter, limit int, after string,
+) ([]domain.AuditEntry, *string, error) {
+	limit = boundLimit(limit)
+
+	q := clientFrom(ctx, auditRepo.client).AuditEntry.
+		Query().
+		// Deterministic total order, newest first: created_at DESC, id DESC
+		Order(
+			auditentry.ByCreatedAt(sql.OrderDesc()),
+			auditentry.ByID(sql.OrderDesc()),
+		)
+
+	if filter.ClaimedActorID != nil {
+		q = q.Where(auditentry.ClaimedActorID(*filter.ClaimedActorID))
+	}
+	if filter.AssetID != nil {
+		q = q.Where(auditentry.AssetID(*filter.AssetID))
+	}
+	if filter.UserID != nil {
+		q = q.Where(auditentry.UserID(*filter.UserID))
+	}
+	if filter.Action != "" {
+		q = q.Where(auditentry.Action(string(filter.Action)))
+	}
+	if filter.RequestID != "" {
+		q = q.Where(auditentry.RequestID(filter.RequestID))
+	}
+	if filter.From != nil {
+		q = q.Where(auditentry.CreatedAtGTE(*filter.From))
+	}
+	if filter.To != nil {
+		q = q.Where(auditentry.CreatedAtLT(*filter.To))
+	}
+
+	// Seek to < (created_at,id) if a cursor was provided.
+	if after != "" {
+		cur, err := auditRepo.cursors.decode(after, auditScope)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", domain.ErrBadCursor, err)
+		}
+		q = q.Where(
+			auditentry.Or(
+				auditentry.CreatedAtLT(cur.T),
+				auditentry.And(
+					auditentry.CreatedAtEQ(cur.T),
+					auditentry.IDLT(cur.I),
+				),
+			),
+		)
+	}
+
+	// Pull one extra to know if there's another page.
+	rows, err := q.Limit(limit + 1).All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nextAfter *string
+	if len(rows) > limit {
+		last := rows[limit-1]
+		cstr, err := auditRepo.cursors.encode(ksCursor{T: last.CreatedAt, I: last.ID}, auditScope)
+		if err != nil {
+			return nil, nil, err
+		}
+		nextAfter = &cstr
+		rows = rows[:limit]
+	}
+
+	entries := make([]domain.AuditEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, toDomainAuditEntry(row))
+	}
+	return entries, nextAfter, nil
+}
+
+// toDomainAuditEntry maps an ent audit entry to the domain model.
+func toDomainAuditEntry(e *ent.AuditEntry) domain.AuditEntry {
+	return domain.AuditEntry{
+		ID:             e.ID,
+		At:             e.CreatedAt,
+		ClaimedActorID: e.ClaimedActorID,
+		RequestID:      e.RequestID,
+		Action:         domain.AuditAction(e.Action),
+		EntityType:     e.EntityType,
+		EntityID:       e.EntityID,
+		AssetID:        e.AssetID,
+		UserID:         e.UserID,
+		Before:         e.Before,
+		After:          e.After,
+	}
+}
@@ -0,0 +1,192 @@
+package entadapter
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/collection"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/favourite"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/ports"
+	"github.com/google/uuid"
+)
+
+// Compile time safety for ports.CollectionRepository implementation.
+var _ ports.CollectionRepository = (*CollectionRepo)(nil)
+
+// CollectionRepo implements ports.CollectionRepository using Ent.
+type CollectionRepo struct {
+	client *ent.Client
+}
+
+func NewCollectionRepo(client *ent.Client) *CollectionRepo {
+	return &CollectionRepo{client: client}
+}
+
+// Create inserts a collection. A duplicate (user_id, name) maps to ErrCollectionAlreadyExists.
+func (collectionRepo *CollectionRepo) Create(ctx context.Context, collectionToCreate *domain.Collection) error {
+	created, err := clientFrom(ctx, collectionRepo.client).Collection.
+		Create().
+		SetUserID(collectionToCreate.UserID).
+		SetName(collectionToCreate.Name).
+		SetCreatedAt(collectionToCreate.CreatedAt).
+		Save(ctx)
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return domain.ErrCollectionAlreadyExists
+		}
+		return err
+	}
+
+	collectionToCreate.ID = created.ID
+	return nil
+}
+
+// Get returns a collection owned by userID. Missing rows map to ErrCollectionNotFound.
+func (collectionRepo *CollectionRepo) Get(ctx context.Context, userID, collectionID uuid.UUID) (*domain.Collection, error) {
+	c, err := clientFrom(ctx, collectionRepo.client).Collection.
+		Query().
+		Where(
+			collection.ID(collectionID),
+			collection.UserID(userID),
+		).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrCollectionNotFound
+		}
+		return nil, err
+	}
+
+	col := toDomainCollection(c)
+	return &col, nil
+}
+
+// ListByUser returns all collections of a user ordered by created_at, id.
+func (collectionRepo *CollectionRepo) ListByUser(ctx context.Context, userID uuid.UUID) ([]domain.Collection, error) {
+	rows, err := clientFrom(ctx, collectionRepo.client).Collection.
+		Query().
+		Where(collection.UserID(userID)).
+		Order(
+			collection.ByCreatedAt(sql.OrderAsc()),
+			collection.ByID(sql.OrderAsc()),
+		).
+		All(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	collections := make([]domain.Collection, 0, len(rows))
+	for _, c := range rows {
+		collections = append(collections, toDomainCollection(c))
+	}
+	return collections, nil
+}
+
+// Update persists the collection name. A duplicate (user_id, name) maps to ErrCollectionAlreadyExists.
+func (collectionRepo *CollectionRepo) Update(ctx context.Context, updatedCollection *domain.Collection) error {
+	_, err := clientFrom(ctx, collectionRepo.client).Collection.
+		UpdateOneID(updatedCollection.ID).
+		SetName(updatedCollection.Name).
+		Save(ctx)
+
+	switch {
+	case ent.IsNotFound(err):
+		return domain.ErrCollectionNotFound
+	case ent.IsConstraintError(err):
+		return domain.ErrCollectionAlreadyExists
+	default:
+		return err
+	}
+}
+
+// Delete removes a collection owned by userID. Memberships are dropped by the join table cascade.
+func (collectionRepo *CollectionRepo) Delete(ctx context.Context, userID, collectionID uuid.UUID) error {
+	n, err := clientFrom(ctx, collectionRepo.client).Collection.
+		Delete().
+		Where(
+			collection.ID(collectionID),
+			collection.UserID(userID),
+		).
+		Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return domain.ErrCollectionNotFound
+	}
+
+	return nil
+}
+
+// AddFavourite links a favourite to a collection. Existing links are left as they are.
+func (collectionRepo *CollectionRepo) AddFavourite(ctx context.Context, collectionID, favouriteID uuid.UUID) error {
+	linked, err := collectionRepo.isMember(ctx, collectionID, favouriteID)
+	if err != nil || linked {
+		return err
+	}
+
+	err = clientFrom(ctx, collectionRepo.client).Collection.
+		UpdateOneID(collectionID).
+		AddFavouriteIDs(favouriteID).
+		Exec(ctx)
+
+	switch {
+	case ent.IsNotFound(err):
+		return domain.ErrCollectionNotFound
+	case ent.IsConstraintError(err):
+		// Linked concurrently: same outcome.
+		return nil
+	default:
+		return err
+	}
+}
+
+// RemoveFavourite unlinks a favourite from a collection. Missing links map to ErrFavouriteNotFound.
+func (collectionRepo *CollectionRepo) RemoveFavourite(ctx context.Context, collectionID, favouriteID uuid.UUID) error {
+	linked, err := collectionRepo.isMember(ctx, collectionID, favouriteID)
+	if err != nil {
+		return err
+	}
+	if !linked {
+		return domain.ErrFavouriteNotFound
+	}
+
+	err = clientFrom(ctx, collectionRepo.client).Collection.
+		UpdateOneID(collectionID).
+		RemoveFavouriteIDs(favouriteID).
+		Exec(ctx)
+
+	if ent.IsNotFound(err) {
+		return domain.ErrCollectionNotFound
+	}
+
+	return err
+}
+
+// isMember reports whether favouriteID is linked to collectionID.
+func (collectionRepo *CollectionRepo) isMember(ctx context.Context, collectionID, favouriteID uuid.UUID) (bool, error) {
+	return clientFrom(ctx, collectionRepo.client).Collection.
+		Query().
+		Where(
+			collection.ID(collectionID),
+			collection.HasFavouritesWith(favourite.ID(favouriteID)),
+		).
+		Exist(ctx)
+}
+
+// toDomainCollection maps an ent collection to the domain model.
+func toDomainCollection(c *ent.Collection) domain.Collection {
+	return domain.Collection{
+		ID:        c.ID,
+		UserID:    c.UserID,
+		Name:      c.Name,
+		CreatedAt: c.CreatedAt,
+	}
+}
@@ -0,0 +1,58 @@
+package entadapter
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestCursorCodec(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	codec := NewCursorCodec([]byte("secret"), time.Hour)
+	codec.now = func() time.Time { return now }
+
+	user := uuid.New()
+	want := ksCursor{T: now.Add(-time.Minute), I: uuid.New(), S: "description", D: "Weekly signups"}
+	s, err := codec.encode(want, favouritesScope(user))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := codec.decode(s, favouritesScope(user))
+	if err != nil {
+		t.Fatalf("decode() error = %v", err)
+	}
+	if !got.T.Equal(want.T) || got.I != want.I || got.S != want.S || got.D != want.D {
+		t.Fatalf("decode() = %+v, want %+v", got, want)
+	}
+
+	payload, sig, _ := strings.Cut(s, ".")
+	otherKey := NewCursorCodec([]byte("other"), time.Hour)
+
+	tests := []struct {
+		name   string
+		codec  *CursorCodec
+		cursor string
+		scope  string
+		at     time.Time
+	}{
+		{name: "malformed", codec: codec, cursor: payload, scope: favouritesScope(user), at: now},
+		{name: "tampered payload", codec: codec, cursor: payload + "x." + sig, scope: favouritesScope(user), at: now},
+		{name: "other key", codec: otherKey, cursor: s, scope: favouritesScope(user), at: now},
+		{name: "other user", codec: codec, cursor: s, scope: favouritesScope(uuid.New()), at: now},
+		{name: "other listing", codec: codec, cursor: s, scope: assetsScope, at: now},
+		{name: "expired", codec: codec, cursor: s, scope: favouritesScope(user), at: now.Add(time.Hour)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			at := tt.at
+			tt.codec.now = func() time.Time { return at }
+			if _, err := tt.codec.decode(tt.cursor, tt.scope); err == nil {
+				t.Fatal("decode() accepted the cursor")
+			}
+		})
+	}
+}
@@ -0,0 +1,333 @@
+package entadapter
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/asset"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/favourite"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/favouritetombstone"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/predicate"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/schema"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/google/uuid"
+)
+
+// deleteWithTombstones removes the favourites matching ps and records a tombstone per removed
+// favourite. It must run inside a transaction and returns how many favourites were removed.
+func deleteWithTombstones(ctx context.Context, client *ent.Client, ps ...predicate.Favourite) (int, error) {
+	rows, err := client.Favourite.
+		Query().
+		Where(ps...).
+		Select(favourite.FieldID, favourite.FieldUserID, favourite.FieldAssetID).
+		All(ctx)
+	if err != nil || len(rows) == 0 {
+		return 0, err
+	}
+
+	if err := saveTombstones(ctx, client, rows); err != nil {
+		return 0, err
+	}
+	ids := make([]uuid.UUID, 0, len(rows))
+	for _, f := range rows {
+		ids = append(ids, f.ID)
+	}
+	return client.Favourite.Delete().Where(favourite.IDIn(ids...)).Exec(ctx)
+}
+
+// saveTombstones records the removal of each of favs from its user's list.
+func saveTombstones(ctx context.Context, client *ent.Client, favs []*ent.Favourite) error {
+	now := time.Now().UTC()
+	tombstones := make([]*ent.FavouriteTombstoneCreate, 0, len(favs))
+	for _, f := range favs {
+		tombstones = append(tombstones, client.FavouriteTombstone.
+			Create().
+			SetUserID(f.UserID).
+			SetAssetID(f.AssetID).
+			SetDeletedAt(now))
+	}
+	return client.FavouriteTombstone.CreateBulk(tombstones...).Exec(ctx)
+}
+
+// recordArchiveChange keeps the change feeds in line with the favourites lists, which hide archived
+// assets: archiving an asset records a removal for each of its favourites, and restoring it moves
+// their changed_at and changed_tx forward so they are listed as added again. It must run inside the
+// transaction that changes the asset.
+func recordArchiveChange(ctx context.Context, client *ent.Client, assetID uuid.UUID, wasArchived, isArchived bool) error {
+	switch {
+	case !wasArchived && isArchived:
+		favs, err := client.Favourite.
+			Query().
+			Where(favourite.AssetID(assetID)).
+			Select(favourite.FieldID, favourite.FieldUserID, favourite.FieldAssetID).
+			All(ctx)
+		if err != nil || len(favs) == 0 {
+			return err
+		}
+		return saveTombstones(ctx, client, favs)
+	case wasArchived && !isArchived:
+		// changed_tx takes a database expression, which ent updates can't set.
+		_, err := client.ExecContext(ctx,
+			"UPDATE "+favourite.Table+" SET "+favourite.FieldChangedAt+" = $1, "+favourite.FieldChangedTx+" = "+schema.CurrentTxExpr+
+				" WHERE "+favourite.FieldAssetID+" = $2",
+			time.Now().UTC(), assetID)
+		return err
+	default:
+		return nil
+	}
+}
+
+// ListChanges merges the user's favourite additions (current favourites of non-archived assets, by
+// changed_tx) and removals (tombstones, by deleted_tx) after the token's position into one
+// (transaction, id) ordered feed. It stops before the oldest transaction still running, so every
+// change it could miss has already been returned. The next token points at the last returned change,
+// or stays put when there is none.
+func (favouriteRepo *FavouriteRepo) ListChanges(
+	ctx context.Context, userID uuid.UUID, token string, limit int,
+) ([]domain.FavouriteChange, string, bool, error) {
+	limit = boundLimit(limit)
+	scope := changesScope(userID)
+
+	var pos ksCursor
+	if token != "" {
+		var err error
+		if pos, err = favouriteRepo.syncTokens.decode(token, scope); err != nil {
+			if errors.Is(err, errCursorExpired) {
+				return nil, "", false, domain.ErrSyncTokenExpired
+			}
+			return nil, "", false, fmt.Errorf("%w: %v", domain.ErrBadCursor, err)
+		}
+	}
+	client := clientFrom(ctx, favouriteRepo.client)
+	horizon, err := commitHorizon(ctx, client)
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	// Each side fetches one extra so the merged page knows whether more changes follow.
+	addQuery := client.Favourite.
+		Query().
+		Where(
+			favourite.UserID(userID),
+			favourite.HasAssetWith(asset.ArchivedAtIsNil()),
+			favourite.ChangedTxLT(horizon),
+		).
+		Order(
+			favourite.ByChangedTx(sql.OrderAsc()),
+			favourite.ByID(sql.OrderAsc()),
+		).
+		WithAsset().
+		Limit(limit + 1)
+	removeQuery := client.FavouriteTombstone.
+		Query().
+		Where(
+			favouritetombstone.UserID(userID),
+			favouritetombstone.DeletedTxLT(horizon),
+		).
+		Order(
+			favouritetombstone.ByDeletedTx(sql.OrderAsc()),
+			favouritetombstone.ByID(sql.OrderAsc()),
+		).
+		Limit(limit + 1)
+	if token != "" {
+		addQuery = addQuery.Where(favourite.Or(
+			favourite.ChangedTxGT(pos.X),
+			favourite.And(favourite.ChangedTxEQ(pos.X), favourite.IDGT(pos.I)),
+		))
+		removeQuery = removeQuery.Where(favouritetombstone.Or(
+			favouritetombstone.DeletedTxGT(pos.X),
+			favouritetombstone.And(favouritetombstone.DeletedTxEQ(pos.X), favouritetombstone.IDGT(pos.I)),
+		))
+	}
+
+	added, err := addQuery.All(ctx)
+	if err != nil {
+		return nil, "", false, err
+	}
+	removed, err := removeQuery.All(ctx)
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	changes, last, more, err := mergeChanges(added, removed, limit)
+	if err != nil {
+		return nil, "", false, err
+	}
+	if last != nil {
+		pos = *last
+	}
+
+	next, err := favouriteRepo.syncTokens.encode(pos, scope)
+	if err != nil {
+		return nil, "", false, err
+	}
+	return changes, next, more, nil
+}
+
+// mergeChanges orders additions and removals by (transaction, id) and keeps the first limit of them.
+// It returns the position of the last kept change (nil when there is none) and whether more followed.
+func mergeChanges(added []*ent.Favourite, removed []*ent.FavouriteTombstone, limit int) ([]domain.FavouriteChange, *ksCursor, bool, error) {
+	entries := make([]positioned[domain.FavouriteChange], 0, len(added)+len(removed))
+	for _, f := range added {
+		a, err := toDomainAsset(f.Edges.Asset)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		entries = append(entries, positioned[domain.FavouriteChange]{tx: f.ChangedTx, id: f.ID, v: domain.FavouriteChange{
+			Kind:      domain.ChangeAdded,
+			AssetID:   f.AssetID,
+			At:        f.ChangedAt,
+			Favourite: &domain.FavouritedAsset{Favourite: toDomainFavourite(f), Asset: *a},
+		}})
+	}
+	for _, t := range removed {
+		entries = append(entries, positioned[domain.FavouriteChange]{tx: t.DeletedTx, id: t.ID, v: domain.FavouriteChange{
+			Kind:    domain.ChangeRemoved,
+			AssetID: t.AssetID,
+			At:      t.DeletedAt,
+		}})
+	}
+
+	entries, more := firstByPosition(entries, limit)
+	changes := make([]domain.FavouriteChange, 0, len(entries))
+	for _, e := range entries {
+		changes = append(changes, e.v)
+	}
+	if len(entries) == 0 {
+		return changes, nil, more, nil
+	}
+	last := entries[len(entries)-1]
+	return changes, &ksCursor{X: last.tx, I: last.id}, more, nil
+}
+
+// ListFeed merges the additions (favourites by changed_tx) and removals (tombstones by deleted_tx) of
+// all users after the position, up to the oldest transaction still running, like ListChanges does
+// for one user. Archived assets are not filtered: their favourites are added, and removed by the
+// tombstones archiving writes.
+func (favouriteRepo *FavouriteRepo) ListFeed(
+	ctx context.Context, after domain.FeedPosition, limit int,
+) ([]domain.FavouriteDelta, domain.FeedPosition, error) {
+	client := clientFrom(ctx, favouriteRepo.client)
+	horizon, err := commitHorizon(ctx, client)
+	if err != nil {
+		return nil, after, err
+	}
+
+	added, err := client.Favourite.
+		Query().
+		Where(
+			favourite.ChangedTxLT(horizon),
+			favourite.Or(
+				favourite.ChangedTxGT(after.Tx),
+				favourite.And(favourite.ChangedTxEQ(after.Tx), favourite.IDGT(after.ID)),
+			),
+		).
+		Order(
+			favourite.ByChangedTx(sql.OrderAsc()),
+			favourite.ByID(sql.OrderAsc()),
+		).
+		Select(favourite.FieldID, favourite.FieldUserID, favourite.FieldAssetID, favourite.FieldChangedTx).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, after, err
+	}
+	removed, err := client.FavouriteTombstone.
+		Query().
+		Where(
+			favouritetombstone.DeletedTxLT(horizon),
+			favouritetombstone.Or(
+				favouritetombstone.DeletedTxGT(after.Tx),
+				favouritetombstone.And(favouritetombstone.DeletedTxEQ(after.Tx), favouritetombstone.IDGT(after.ID)),
+			),
+		).
+		Order(
+			favouritetombstone.ByDeletedTx(sql.OrderAsc()),
+			favouritetombstone.ByID(sql.OrderAsc()),
+		).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, after, err
+	}
+
+	entries := make([]positioned[domain.FavouriteDelta], 0, len(added)+len(removed))
+	for _, f := range added {
+		entries = append(entries, positioned[domain.FavouriteDelta]{tx: f.ChangedTx, id: f.ID, v: domain.FavouriteDelta{
+			Kind: domain.ChangeAdded, UserID: f.UserID, AssetID: f.AssetID,
+		}})
+	}
+	for _, t := range removed {
+		entries = append(entries, positioned[domain.FavouriteDelta]{tx: t.DeletedTx, id: t.ID, v: domain.FavouriteDelta{
+			Kind: domain.ChangeRemoved, UserID: t.UserID, AssetID: t.AssetID,
+		}})
+	}
+
+	entries, _ = firstByPosition(entries, limit)
+	deltas := make([]domain.FavouriteDelta, 0, len(entries))
+	for _, e := range entries {
+		deltas = append(deltas, e.v)
+	}
+	if len(entries) > 0 {
+		last := entries[len(entries)-1]
+		after = domain.FeedPosition{Tx: last.tx, ID: last.id}
+	}
+	return deltas, after, nil
+}
+
+// positioned is a change feed entry at its (transaction, id) position.
+type positioned[T any] struct {
+	tx int64
+	id uuid.UUID
+	v  T
+}
+
+// firstByPosition sorts entries by position and keeps the first limit of them, reporting whether
+// any were dropped. Within a transaction, IDs are compared as strings, which order like the UUIDs
+// the queries compare.
+func firstByPosition[T any](entries []positioned[T], limit int) ([]positioned[T], bool) {
+	slices.SortFunc(entries, func(a, b positioned[T]) int {
+		if c := cmp.Compare(a.tx, b.tx); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.id.String(), b.id.String())
+	})
+	if len(entries) > limit {
+		return entries[:limit], true
+	}
+	return entries, false
+}
+
+// commitHorizon returns the oldest transaction still running (the xmin of a fresh snapshot). Every
+// transaction before it has committed or rolled back, so the changes stamped with them are all
+// visible and no later commit can add one.
+func commitHorizon(ctx context.Context, client *ent.Client) (int64, error) {
+	rows, err := client.QueryContext(ctx, "SELECT pg_snapshot_xmin(pg_current_snapshot())::text::bigint")
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	var horizon int64
+	if !rows.Next() {
+		return 0, cmp.Or(rows.Err(), errors.New("no snapshot horizon"))
+	}
+	if err := rows.Scan(&horizon); err != nil {
+		return 0, err
+	}
+	return horizon, rows.Err()
+}
+
+// PruneTombstones deletes removal records older than before.
+func (favouriteRepo *FavouriteRepo) PruneTombstones(ctx context.Context, before time.Time) (int, error) {
+	return clientFrom(ctx, favouriteRepo.client).FavouriteTombstone.
+		Delete().
+		Where(favouritetombstone.DeletedAtLT(before)).
+		Exec(ctx)
+}
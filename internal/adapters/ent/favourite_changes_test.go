@@ -0,0 +1,70 @@
+package entadapter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/ent"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/asset"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/google/uuid"
+)
+
+func TestMergeChangesOrdersByTransaction(t *testing.T) {
+	// Stamped out of wall-clock order: the transaction that commits last may have started first.
+	now := time.Now().UTC()
+	id := func(n byte) uuid.UUID { return uuid.UUID{15: n} }
+	added := func(tx int64, n byte, at time.Time) *ent.Favourite {
+		return &ent.Favourite{
+			ID: id(n), AssetID: id(n), ChangedTx: tx, ChangedAt: at, CreatedAt: at,
+			Edges: ent.FavouriteEdges{Asset: &ent.Asset{ID: id(n), AssetType: asset.AssetTypeInsight}},
+		}
+	}
+	removed := func(tx int64, n byte, at time.Time) *ent.FavouriteTombstone {
+		return &ent.FavouriteTombstone{ID: id(n), AssetID: id(n), DeletedTx: tx, DeletedAt: at}
+	}
+
+	adds := []*ent.Favourite{added(7, 1, now), added(9, 3, now.Add(-time.Minute))}
+	removes := []*ent.FavouriteTombstone{removed(8, 2, now.Add(time.Minute)), removed(9, 4, now)}
+
+	tests := []struct {
+		name     string
+		limit    int
+		want     []byte // asset IDs in order
+		wantLast *ksCursor
+		wantMore bool
+	}{
+		{name: "all", limit: 10, want: []byte{1, 2, 3, 4}, wantLast: &ksCursor{X: 9, I: id(4)}},
+		{name: "first page", limit: 2, want: []byte{1, 2}, wantLast: &ksCursor{X: 8, I: id(2)}, wantMore: true},
+		{name: "same transaction by id", limit: 3, want: []byte{1, 2, 3}, wantLast: &ksCursor{X: 9, I: id(3)}, wantMore: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, last, more, err := mergeChanges(adds, removes, tt.limit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := make([]byte, 0, len(changes))
+			for _, c := range changes {
+				got = append(got, c.AssetID[15])
+			}
+			if string(got) != string(tt.want) {
+				t.Fatalf("mergeChanges() assets = %v, want %v", got, tt.want)
+			}
+			if more != tt.wantMore {
+				t.Fatalf("mergeChanges() more = %v, want %v", more, tt.wantMore)
+			}
+			if *last != *tt.wantLast {
+				t.Fatalf("mergeChanges() last = %+v, want %+v", *last, *tt.wantLast)
+			}
+		})
+	}
+
+	if _, last, _, _ := mergeChanges(nil, nil, 10); last != nil {
+		t.Fatalf("mergeChanges() of nothing moved the position to %+v", *last)
+	}
+	if changes, _, _, _ := mergeChanges(adds, removes, 10); changes[0].Kind != domain.ChangeAdded || changes[1].Kind != domain.ChangeRemoved {
+		t.Fatalf("mergeChanges() kinds = %s, %s", changes[0].Kind, changes[1].Kind)
+	}
+}
@@ -2,14 +2,17 @@ package entadapter
 
 import (
 	"context"
-	"encoding/base64"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/SokratisChaimanas/platform-go-challenge/ent"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/asset"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/collection"
 	"github.com/SokratisChaimanas/platform-go-challenge/ent/favourite"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/predicate"
 	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
 	"github.com/SokratisChaimanas/platform-go-challenge/internal/ports"
 	"github.com/google/uuid"
@@ -20,155 +23,410 @@ var _ ports.FavouriteRepository = (*FavouriteRepo)(nil)
 
 // FavouriteRepo implements ports.FavouriteRepository using Ent.
 type FavouriteRepo struct {
-	client *ent.Client
+	client     *ent.Client
+	cursors    *CursorCodec
+	syncTokens *CursorCodec // longer-lived than cursors: tokens last as long as tombstones are kept
 }
 
-func NewFavouriteRepo(client *ent.Client) *FavouriteRepo {
-	return &FavouriteRepo{client: client}
+func NewFavouriteRepo(client *ent.Client, cursors, syncTokens *CursorCodec) *FavouriteRepo {
+	return &FavouriteRepo{client: client, cursors: cursors, syncTokens: syncTokens}
 }
 
-// Create inserts a new favourite. Duplicate entries map to ErrFavouriteAlreadyExists.
+// Create inserts a new favourite. Duplicate entries map to ErrFavouriteAlreadyExists and
+// a missing asset to ErrAssetNotFound.
+// It runs in a transaction so the audit entry commits with the favourite.
 func (favouriteRepo *FavouriteRepo) Create(ctx context.Context, favouriteToCreate *domain.Favourite) error {
-	_, err := favouriteRepo.client.Favourite.
-		Create().
-		SetUserID(favouriteToCreate.UserID).
-		SetAssetID(favouriteToCreate.AssetID).
-		SetCreatedAt(favouriteToCreate.CreatedAt).
-		Save(ctx)
+	var created *ent.Favourite
+	err := inTx(ctx, favouriteRepo.client, func(ctx context.Context) error {
+		var err error
+		created, err = clientFrom(ctx, favouriteRepo.client).Favourite.
+			Create().
+			SetUserID(favouriteToCreate.UserID).
+			SetAssetID(favouriteToCreate.AssetID).
+			SetNote(favouriteToCreate.Note).
+			SetCreatedAt(favouriteToCreate.CreatedAt).
+			SetChangedAt(favouriteToCreate.CreatedAt).
+			Save(ctx)
+		return err
+	})
 
-	return err
+	if err != nil {
+		return favouriteInsertError(err)
+	}
+
+	favouriteToCreate.ID = created.ID
+	return nil
 }
 
-// Delete removes a favourite by (userID, assetID). Missing rows map to ErrFavouriteNotFound.
-func (favouriteRepo *FavouriteRepo) Delete(ctx context.Context, userID, assetID uuid.UUID) error {
-	f, err := favouriteRepo.client.Favourite.
-		Delete().
+// Get returns the favourite for (userID, assetID). Missing rows map to ErrFavouriteNotFound.
+func (favouriteRepo *FavouriteRepo) Get(ctx context.Context, userID, assetID uuid.UUID) (*domain.Favourite, error) {
+	f, err := clientFrom(ctx, favouriteRepo.client).Favourite.
+		Query().
 		Where(
 			favourite.UserID(userID),
 			favourite.AssetID(assetID),
 		).
-		Exec(ctx)
+		Only(ctx)
 
 	if err != nil {
-		return err
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrFavouriteNotFound
+		}
+		return nil, err
 	}
 
-	if f == 0 {
+	fav := toDomainFavourite(f)
+	return &fav, nil
+}
+
+func (favouriteRepo *FavouriteRepo) GetMany(ctx context.Context, userID uuid.UUID, assetIDs []uuid.UUID) ([]domain.Favourite, error) {
+	rows, err := clientFrom(ctx, favouriteRepo.client).Favourite.
+		Query().
+		Where(
+			favourite.UserID(userID),
+			favourite.AssetIDIn(assetIDs...),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	favs := make([]domain.Favourite, 0, len(rows))
+	for _, f := range rows {
+		favs = append(favs, toDomainFavourite(f))
+	}
+	return favs, nil
+}
+
+// Update persists the favourite note. Missing rows map to ErrFavouriteNotFound.
+func (favouriteRepo *FavouriteRepo) Update(ctx context.Context, updatedFavourite *domain.Favourite) error {
+	err := inTx(ctx, favouriteRepo.client, func(ctx context.Context) error {
+		return clientFrom(ctx, favouriteRepo.client).Favourite.
+			UpdateOneID(updatedFavourite.ID).
+			SetNote(updatedFavourite.Note).
+			Exec(ctx)
+	})
+
+	if ent.IsNotFound(err) {
 		return domain.ErrFavouriteNotFound
 	}
 
-	return nil
+	return err
 }
 
-// Exists checks if a favourite already exists for (userID, assetID).
-func (favouriteRepo *FavouriteRepo) Exists(ctx context.Context, userID, assetID uuid.UUID) (bool, error) {
-	return favouriteRepo.client.Favourite.
-		Query().
-		Where(
+// Delete removes a favourite by (userID, assetID). Missing rows map to ErrFavouriteNotFound.
+func (favouriteRepo *FavouriteRepo) Delete(ctx context.Context, userID, assetID uuid.UUID) error {
+	return inTx(ctx, favouriteRepo.client, func(ctx context.Context) error {
+		n, err := deleteWithTombstones(ctx, clientFrom(ctx, favouriteRepo.client),
 			favourite.UserID(userID),
 			favourite.AssetID(assetID),
-		).
-		Exist(ctx)
+		)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return domain.ErrFavouriteNotFound
+		}
+		return nil
+	})
 }
 
-// ListAssetsFavouritedByUserKeyset returns assets favourited by userID using keyset pagination.
+// ListAssetsFavouritedByUserKeyset returns assets favourited by userID and matching filter,
+// in filter.Sort order. A before cursor scans the reversed order and flips the page back.
 func (favouriteRepo *FavouriteRepo) ListAssetsFavouritedByUserKeyset(
-	ctx context.Context, userID uuid.UUID, limit int, after string,
-) ([]domain.Asset, *string, error) {
+	ctx context.Context, userID uuid.UUID, filter ports.FavouriteFilter, limit int, after, before string,
+) ([]domain.FavouritedAsset, ports.PageCursors, error) {
 
 	// Bound limits the same way your parsePagination does (default 20, cap 50).
-	if limit <= 0 {
-		limit = 20
-	} else if limit > 50 {
-		limit = 50
+	limit = boundLimit(limit)
+
+	sort := filter.Sort
+	if sort == "" {
+		sort = domain.SortCreatedAsc
 	}
+	backward := before != ""
 
-	q := favouriteRepo.client.Favourite.
-		Query().
-		Where(favourite.UserID(userID)).
-		// Deterministic total order: created_at ASC, id ASC
-		Order(
-			favourite.ByCreatedAt(sql.OrderAsc()),
-			favourite.ByID(sql.OrderAsc()),
-		).
+	q := favouriteRepo.listQuery(ctx, userID, filter).
+		// Deterministic total order for the chosen sort (reversed when paging backward), id breaks ties.
+		Order(favouriteOrder(sort, backward)...).
 		WithAsset() // eager load assets since we return assets, not favourites
 
-	// Seek to > (created_at,id) if a cursor was provided.
-	if after != "" {
-		cur, err := decodeCursor(after)
+	// Seek past the cursor row, in scan direction, if a cursor was provided.
+	if raw := after + before; raw != "" {
+		cur, err := favouriteRepo.cursors.decode(raw, favouritesScope(userID))
 		if err != nil {
-			return nil, nil, fmt.Errorf("%w: %v", domain.ErrBadCursor, err)
+			return nil, ports.PageCursors{}, fmt.Errorf("%w: %v", domain.ErrBadCursor, err)
 		}
-		q = q.Where(
-			favourite.Or(
-				favourite.CreatedAtGT(cur.T),
-				favourite.And(
-					favourite.CreatedAtEQ(cur.T),
-					favourite.IDGT(cur.I),
-				),
-			),
-		)
+		if cur.S != string(sort) {
+			return nil, ports.PageCursors{}, fmt.Errorf("%w: cursor was issued for sort %q", domain.ErrBadCursor, cur.S)
+		}
+		q = q.Where(favouriteSeek(sort, cur, backward))
 	}
 
-	// Pull one extra to know if there's another page.
+	// Pull one extra to know if there's another page in scan direction.
 	rows, err := q.Limit(limit + 1).All(ctx)
 	if err != nil {
-		return nil, nil, err
+		return nil, ports.PageCursors{}, err
+	}
+	more := len(rows) > limit
+	if more {
+		rows = rows[:limit]
+	}
+	if backward {
+		slices.Reverse(rows)
 	}
 
-	var nextAfter *string
-	if len(rows) > limit {
-		last := rows[limit-1]
-		cstr, err := encodeCursor(ksCursor{T: last.CreatedAt, I: last.ID})
-		if err != nil {
-			return nil, nil, err
+	// A page reached through a cursor always has rows on the side it came from.
+	var cursors ports.PageCursors
+	if len(rows) > 0 {
+		hasPrev, hasNext := after != "", more
+		if backward {
+			hasPrev, hasNext = more, true
+		}
+		if hasPrev {
+			if cursors.PrevBefore, err = favouriteRepo.pageCursor(userID, sort, rows[0]); err != nil {
+				return nil, ports.PageCursors{}, err
+			}
+		}
+		if hasNext {
+			if cursors.NextAfter, err = favouriteRepo.pageCursor(userID, sort, rows[len(rows)-1]); err != nil {
+				return nil, ports.PageCursors{}, err
+			}
 		}
-		nextAfter = &cstr
-		rows = rows[:limit]
 	}
 
-	assets := make([]domain.Asset, 0, len(rows))
+	items := make([]domain.FavouritedAsset, 0, len(rows))
 	for _, f := range rows {
-		a := f.Edges.Asset
-		if a == nil {
+		if f.Edges.Asset == nil {
 			// Shouldn't happen because WithAsset(), but guard anyway.
 			continue
 		}
-		assets = append(assets, domain.Asset{
-			ID:          a.ID,
-			Type:        domain.AssetType(a.AssetType),
-			Description: a.Description,
-			Payload:     a.Payload,
+		a, err := toDomainAsset(f.Edges.Asset)
+		if err != nil {
+			return nil, ports.PageCursors{}, err
+		}
+		items = append(items, domain.FavouritedAsset{
+			Favourite: toDomainFavourite(f),
+			Asset:     *a,
 		})
 	}
 
-	return assets, nextAfter, nil
+	return items, cursors, nil
 }
 
-// ksCursor encodes the "position" of the last favourite (created_at, id).
-type ksCursor struct {
-	T time.Time `json:"t"` // favourite.created_at
-	I uuid.UUID `json:"i"` // favourite.id
+// CountAssetsFavouritedByUser counts the favourites ListAssetsFavouritedByUserKeyset pages through.
+func (favouriteRepo *FavouriteRepo) CountAssetsFavouritedByUser(ctx context.Context, userID uuid.UUID, filter ports.FavouriteFilter) (int, error) {
+	return favouriteRepo.listQuery(ctx, userID, filter).Count(ctx)
 }
 
-// encodeCursor turns a cursor into a URL-safe base64 string.
-func encodeCursor(c ksCursor) (string, error) {
-	b, err := json.Marshal(c)
+// ListMostFavourited groups favourites by asset, counting them (since the given time, if any),
+// and loads the ranked non-archived assets in ranking order.
+func (favouriteRepo *FavouriteRepo) ListMostFavourited(
+	ctx context.Context, since *time.Time, limit, offset int,
+) ([]domain.PopularAsset, bool, error) {
+	limit = boundLimit(limit)
+	client := clientFrom(ctx, favouriteRepo.client)
+
+	q := client.Favourite.
+		Query().
+		Where(favourite.HasAssetWith(asset.ArchivedAtIsNil()))
+	if since != nil {
+		q = q.Where(favourite.CreatedAtGTE(*since))
+	}
+
+	var counts []struct {
+		AssetID uuid.UUID `json:"asset_id"`
+		Count   int       `json:"count"`
+	}
+	err := q.
+		GroupBy(favourite.FieldAssetID).
+		Aggregate(func(s *sql.Selector) string {
+			// GroupBy has no ordering or paging of its own, so rank on the grouped selector.
+			s.OrderBy(sql.Desc("count"), sql.Asc(s.C(favourite.FieldAssetID))).
+				Limit(limit + 1).
+				Offset(offset)
+			return sql.As(sql.Count("*"), "count")
+		}).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, false, err
+	}
+
+	more := len(counts) > limit
+	if more {
+		counts = counts[:limit]
+	}
+
+	ids := make([]uuid.UUID, 0, len(counts))
+	for _, c := range counts {
+		ids = append(ids, c.AssetID)
+	}
+	rows, err := client.Asset.Query().Where(asset.IDIn(ids...)).All(ctx)
 	if err != nil {
-		return "", err
+		return nil, false, err
+	}
+	byID := make(map[uuid.UUID]*ent.Asset, len(rows))
+	for _, row := range rows {
+		byID[row.ID] = row
+	}
+
+	items := make([]domain.PopularAsset, 0, len(counts))
+	for _, c := range counts {
+		row, ok := byID[c.AssetID]
+		if !ok {
+			// Deleted between the two queries.
+			continue
+		}
+		a, err := toDomainAsset(row)
+		if err != nil {
+			return nil, false, err
+		}
+		items = append(items, domain.PopularAsset{Asset: *a, Favourites: c.Count})
 	}
-	return base64.RawURLEncoding.EncodeToString(b), nil
+	return items, more, nil
 }
 
-// decodeCursor parses a URL-safe base64 cursor string.
-func decodeCursor(s string) (ksCursor, error) {
-	var c ksCursor
-	b, err := base64.RawURLEncoding.DecodeString(s)
+// listQuery selects userID's favourites of non-archived assets matching filter.
+func (favouriteRepo *FavouriteRepo) listQuery(ctx context.Context, userID uuid.UUID, filter ports.FavouriteFilter) *ent.FavouriteQuery {
+	q := clientFrom(ctx, favouriteRepo.client).Favourite.
+		Query().
+		Where(
+			favourite.UserID(userID),
+			// Favourites of archived assets are kept but hidden.
+			favourite.HasAssetWith(asset.ArchivedAtIsNil()),
+		)
+
+	if filter.CollectionID != nil {
+		q = q.Where(favourite.HasCollectionsWith(collection.ID(*filter.CollectionID)))
+	}
+	if filter.AssetType != "" {
+		q = q.Where(favourite.HasAssetWith(asset.AssetTypeEQ(asset.AssetType(filter.AssetType))))
+	}
+	return q
+}
+
+// pageCursor encodes the position of row f in userID's listing with the given sort.
+func (favouriteRepo *FavouriteRepo) pageCursor(userID uuid.UUID, sort domain.FavouriteSort, f *ent.Favourite) (*string, error) {
+	cur := ksCursor{T: f.CreatedAt, I: f.ID, S: string(sort)}
+	if sort == domain.SortDescription && f.Edges.Asset != nil {
+		cur.D = f.Edges.Asset.Description
+	}
+	cstr, err := favouriteRepo.cursors.encode(cur, favouritesScope(userID))
 	if err != nil {
-		return c, err
+		return nil, err
 	}
-	if err := json.Unmarshal(b, &c); err != nil {
-		return c, err
+	return &cstr, nil
+}
+
+// favouriteAscending reports whether a sort is scanned in ascending order.
+func favouriteAscending(sort domain.FavouriteSort, backward bool) bool {
+	return (sort != domain.SortCreatedDesc) != backward
+}
+
+// favouriteOrder returns the total order of a favourites listing for the given sort and scan direction.
+func favouriteOrder(sort domain.FavouriteSort, backward bool) []favourite.OrderOption {
+	dir := sql.OrderDesc()
+	if favouriteAscending(sort, backward) {
+		dir = sql.OrderAsc()
 	}
-	return c, nil
+	if sort == domain.SortDescription {
+		return []favourite.OrderOption{
+			favourite.ByAssetField(asset.FieldDescription, dir),
+			favourite.ByID(dir),
+		}
+	}
+	return []favourite.OrderOption{
+		favourite.ByCreatedAt(dir),
+		favourite.ByID(dir),
+	}
+}
+
+// favouriteSeek matches the rows that come after cur in the given sort and scan direction.
+func favouriteSeek(sort domain.FavouriteSort, cur ksCursor, backward bool) predicate.Favourite {
+	asc := favouriteAscending(sort, backward)
+	if sort == domain.SortDescription {
+		if asc {
+			return favourite.Or(
+				favourite.HasAssetWith(asset.DescriptionGT(cur.D)),
+				favourite.And(
+					favourite.HasAssetWith(asset.DescriptionEQ(cur.D)),
+					favourite.IDGT(cur.I),
+				),
+			)
+		}
+		return favourite.Or(
+			favourite.HasAssetWith(asset.DescriptionLT(cur.D)),
+			favourite.And(
+				favourite.HasAssetWith(asset.DescriptionEQ(cur.D)),
+				favourite.IDLT(cur.I),
+			),
+		)
+	}
+	if asc {
+		return favourite.Or(
+			favourite.CreatedAtGT(cur.T),
+			favourite.And(
+				favourite.CreatedAtEQ(cur.T),
+				favourite.IDGT(cur.I),
+			),
+		)
+	}
+	return favourite.Or(
+		favourite.CreatedAtLT(cur.T),
+		favourite.And(
+			favourite.CreatedAtEQ(cur.T),
+			favourite.IDLT(cur.I),
+		),
+	)
+}
+
+// toDomainFavourite maps an ent favourite to the domain model.
+func toDomainFavourite(f *ent.Favourite) domain.Favourite {
+	return domain.Favourite{
+		ID:        f.ID,
+		UserID:    f.UserID,
+		AssetID:   f.AssetID,
+		Note:      f.Note,
+		CreatedAt: f.CreatedAt,
+	}
+}
+
+// CreateMany inserts favourites in a transaction and sets the generated IDs of the created ones.
+// Each insert runs in its own savepoint, so a pair added concurrently or an asset deleted meanwhile
+// only skips that row. The returned outcomes line up with favs: created, already_exists or asset_not_found.
+func (favouriteRepo *FavouriteRepo) CreateMany(ctx context.Context, favs []domain.Favourite) ([]domain.FavouriteOutcome, error) {
+	outcomes := make([]domain.FavouriteOutcome, len(favs))
+	err := inTx(ctx, favouriteRepo.client, func(ctx context.Context) error {
+		for i := range favs {
+			err := withSavepoint(ctx, "favourite_insert", func(ctx context.Context) error {
+				return favouriteRepo.Create(ctx, &favs[i])
+			})
+			switch {
+			case err == nil:
+				outcomes[i] = domain.FavouriteCreated
+			case errors.Is(err, domain.ErrFavouriteAlreadyExists):
+				outcomes[i] = domain.FavouriteAlreadyExists
+			case errors.Is(err, domain.ErrAssetNotFound):
+				outcomes[i] = domain.FavouriteAssetNotFound
+			default:
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return outcomes, nil
+}
+
+// DeleteMany removes userID's favourites of assetIDs, with tombstones; assets that are not favourited are ignored.
+func (favouriteRepo *FavouriteRepo) DeleteMany(ctx context.Context, userID uuid.UUID, assetIDs []uuid.UUID) error {
+	return inTx(ctx, favouriteRepo.client, func(ctx context.Context) error {
+		_, err := deleteWithTombstones(ctx, clientFrom(ctx, favouriteRepo.client),
+			favourite.UserID(userID),
+			favourite.AssetIDIn(assetIDs...),
+		)
+		return err
+	})
 }
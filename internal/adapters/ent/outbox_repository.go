@@ -0,0 +1,161 @@
+package entadapter
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/outboxevent"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/ports"
+	"github.com/google/uuid"
+)
+
+// Compile time safety for ports.OutboxRepository implementation.
+var _ ports.OutboxRepository = (*OutboxRepo)(nil)
+
+// OutboxRepo implements ports.OutboxRepository using Ent.
+type OutboxRepo struct {
+	client *ent.Client
+}
+
+func NewOutboxRepo(client *ent.Client) *OutboxRepo {
+	return &OutboxRepo{client: client}
+}
+
+// Append inserts events in one statement and copies the generated IDs back.
+func (outboxRepo *OutboxRepo) Append(ctx context.Context, events []domain.OutboxEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	client := clientFrom(ctx, outboxRepo.client)
+	creates := make([]*ent.OutboxEventCreate, 0, len(events))
+	for _, e := range events {
+		creates = append(creates, client.OutboxEvent.
+			Create().
+			SetEventType(string(e.Type)).
+			SetPayload(e.Payload).
+			SetCreatedAt(e.CreatedAt).
+			SetAvailableAt(e.AvailableAt))
+	}
+
+	created, err := client.OutboxEvent.CreateBulk(creates...).Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range events {
+		events[i].ID = created[i].ID
+	}
+	return nil
+}
+
+// ClaimPending leases available unpublished events. Each claim is a conditional update on the
+// available_at that was read, so an event another relay claimed in between is skipped.
+func (outboxRepo *OutboxRepo) ClaimPending(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]domain.OutboxEvent, error) {
+	client := clientFrom(ctx, outboxRepo.client)
+
+	rows, err := client.OutboxEvent.
+		Query().
+		Where(
+			outboxevent.PublishedAtIsNil(),
+			outboxevent.AvailableAtLTE(now),
+		).
+		Order(
+			outboxevent.ByCreatedAt(sql.OrderAsc()),
+			outboxevent.ByID(sql.OrderAsc()),
+		).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed := make([]domain.OutboxEvent, 0, len(rows))
+	for _, row := range rows {
+		n, err := client.OutboxEvent.
+			Update().
+			Where(
+				outboxevent.ID(row.ID),
+				outboxevent.PublishedAtIsNil(),
+				outboxevent.AvailableAt(row.AvailableAt),
+			).
+			SetAvailableAt(now.Add(lease)).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if n == 1 {
+			claimed = append(claimed, toDomainOutboxEvent(row))
+		}
+	}
+	return claimed, nil
+}
+
+// MarkPublished stamps the event as published.
+func (outboxRepo *OutboxRepo) MarkPublished(ctx context.Context, id uuid.UUID, at time.Time) error {
+	return clientFrom(ctx, outboxRepo.client).OutboxEvent.
+		UpdateOneID(id).
+		SetPublishedAt(at).
+		Exec(ctx)
+}
+
+// RecordFailure persists the retry bookkeeping of an event, including the publishers it already reached.
+func (outboxRepo *OutboxRepo) RecordFailure(ctx context.Context, e *domain.OutboxEvent) error {
+	return clientFrom(ctx, outboxRepo.client).OutboxEvent.
+		UpdateOneID(e.ID).
+		SetAttempts(e.Attempts).
+		SetLastError(e.LastError).
+		SetPublishedTo(e.PublishedTo).
+		SetAvailableAt(e.AvailableAt).
+		Exec(ctx)
+}
+
+// Backlog counts unpublished events and finds the creation time of the oldest one.
+func (outboxRepo *OutboxRepo) Backlog(ctx context.Context) (int, *time.Time, error) {
+	q := clientFrom(ctx, outboxRepo.client).OutboxEvent.
+		Query().
+		Where(outboxevent.PublishedAtIsNil())
+
+	count, err := q.Clone().Count(ctx)
+	if err != nil || count == 0 {
+		return 0, nil, err
+	}
+
+	oldest, err := q.
+		Order(outboxevent.ByCreatedAt(sql.OrderAsc())).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			// Published since it was counted.
+			return count, nil, nil
+		}
+		return 0, nil, err
+	}
+	return count, &oldest.CreatedAt, nil
+}
+
+// PrunePublished deletes events published before before.
+func (outboxRepo *OutboxRepo) PrunePublished(ctx context.Context, before time.Time) (int, error) {
+	return clientFrom(ctx, outboxRepo.client).OutboxEvent.
+		Delete().
+		Where(outboxevent.PublishedAtLT(before)).
+		Exec(ctx)
+}
+
+// toDomainOutboxEvent maps an ent outbox event to the domain model.
+func toDomainOutboxEvent(e *ent.OutboxEvent) domain.OutboxEvent {
+	return domain.OutboxEvent{
+		ID:          e.ID,
+		Type:        domain.EventType(e.EventType),
+		Payload:     e.Payload,
+		CreatedAt:   e.CreatedAt,
+		AvailableAt: e.AvailableAt,
+		Attempts:    e.Attempts,
+		LastError:   e.LastError,
+		PublishedTo: e.PublishedTo,
+		PublishedAt: e.PublishedAt,
+	}
+}
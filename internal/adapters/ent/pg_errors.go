@@ -0,0 +1,41 @@
+package entadapter
+
+import (
+	"errors"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Postgres error codes mapped to domain errors.
+const (
+	pgUniqueViolation     = "23505"
+	pgForeignKeyViolation = "23503"
+)
+
+// Constraint names, as generated in ent/migrate/schema.go.
+const (
+	favouriteUserAssetIndex  = "favourite_user_id_asset_id"
+	favouriteAssetForeignKey = "favourites_assets_favourites"
+)
+
+// isViolation reports whether err is the Postgres error code raised by the named constraint.
+func isViolation(err error, code, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == code && pgErr.ConstraintName == constraint
+}
+
+// favouriteInsertError maps the constraint violations of a favourite insert to domain errors.
+func favouriteInsertError(err error) error {
+	switch {
+	case isViolation(err, pgUniqueViolation, favouriteUserAssetIndex):
+		// The unique (user_id, asset_id) index is the source of truth for duplicates,
+		// so concurrent adds of the same pair can't both succeed.
+		return domain.ErrFavouriteAlreadyExists
+	case isViolation(err, pgForeignKeyViolation, favouriteAssetForeignKey):
+		// The asset was deleted after it was looked up.
+		return domain.ErrAssetNotFound
+	default:
+		return err
+	}
+}
@@ -0,0 +1,84 @@
+package entadapter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/ent"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/ports"
+)
+
+// Compile time safety for ports.UnitOfWork implementation.
+var _ ports.UnitOfWork = (*UnitOfWork)(nil)
+
+// UnitOfWork runs use-cases inside an ent transaction carried by the context.
+type UnitOfWork struct {
+	client *ent.Client
+}
+
+func NewUnitOfWork(client *ent.Client) *UnitOfWork {
+	return &UnitOfWork{client: client}
+}
+
+// WithinTx starts a transaction, stores it in ctx for the repositories and commits when fn succeeds.
+// If ctx already carries a transaction, fn simply joins it and the outer call decides the outcome.
+func (unitOfWork *UnitOfWork) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if ent.TxFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
+	tx, err := unitOfWork.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err := fn(ent.NewTxContext(ctx, tx)); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w (rollback: %v)", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// clientFrom returns the client of the transaction carried by ctx, or client when there is none.
+func clientFrom(ctx context.Context, client *ent.Client) *ent.Client {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+	return client
+}
+
+// inTx runs fn in the transaction carried by ctx, or in a new one on client when there is none.
+func inTx(ctx context.Context, client *ent.Client, fn func(ctx context.Context) error) error {
+	return NewUnitOfWork(client).WithinTx(ctx, fn)
+}
+
+// withSavepoint runs fn in a savepoint of the transaction carried by ctx. When fn fails only its writes
+// are rolled back, and the transaction stays usable (Postgres aborts it on a failed statement otherwise).
+func withSavepoint(ctx context.Context, name string, fn func(ctx context.Context) error) error {
+	tx := ent.TxFromContext(ctx)
+	if tx == nil {
+		return fmt.Errorf("savepoint %s: no transaction in context", name)
+	}
+	client := tx.Client()
+
+	if _, err := client.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return err
+	}
+	if err := fn(ctx); err != nil {
+		if _, rerr := client.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name); rerr != nil {
+			return fmt.Errorf("%w (rollback to savepoint: %v)", err, rerr)
+		}
+		return err
+	}
+	_, err := client.ExecContext(ctx, "RELEASE SAVEPOINT "+name)
+	return err
+}
@@ -24,7 +24,7 @@ func NewUserRepo(client *ent.Client) *UserRepo {
 
 // Get returns the user with the given id.
 func (userRepo *UserRepo) Get(ctx context.Context, id uuid.UUID) (*domain.User, error) {
-	u, err := userRepo.client.User.Get(ctx, id)
+	u, err := clientFrom(ctx, userRepo.client).User.Get(ctx, id)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, domain.ErrUserNotFound
@@ -40,7 +40,7 @@ func (userRepo *UserRepo) Get(ctx context.Context, id uuid.UUID) (*domain.User,
 
 // Exists checks if a user with this id exists in DB.
 func (userRepo *UserRepo) Exists(ctx context.Context, id uuid.UUID) (bool, error) {
-	return userRepo.client.User.
+	return clientFrom(ctx, userRepo.client).User.
 		Query().
 		Where(user.ID(id)).
 		Exist(ctx)
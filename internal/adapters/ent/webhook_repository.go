@@ -0,0 +1,311 @@
+package entadapter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/webhookdelivery"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/webhooksubscription"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/ports"
+	"github.com/google/uuid"
+)
+
+// Compile time safety for ports.WebhookRepository implementation.
+var _ ports.WebhookRepository = (*WebhookRepo)(nil)
+
+// WebhookRepo implements ports.WebhookRepository using Ent.
+type WebhookRepo struct {
+	client  *ent.Client
+	cursors *CursorCodec
+}
+
+func NewWebhookRepo(client *ent.Client, cursors *CursorCodec) *WebhookRepo {
+	return &WebhookRepo{client: client, cursors: cursors}
+}
+
+// CreateSubscription inserts a subscription and copies the generated ID back to the domain model.
+func (webhookRepo *WebhookRepo) CreateSubscription(ctx context.Context, subscriptionToCreate *domain.WebhookSubscription) error {
+	created, err := clientFrom(ctx, webhookRepo.client).WebhookSubscription.
+		Create().
+		SetURL(subscriptionToCreate.URL).
+		SetSecret(subscriptionToCreate.Secret).
+		SetEventTypes(eventTypeStrings(subscriptionToCreate.EventTypes)).
+		SetActive(subscriptionToCreate.Active).
+		SetCreatedAt(subscriptionToCreate.CreatedAt).
+		Save(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	subscriptionToCreate.ID = created.ID
+	return nil
+}
+
+// GetSubscription returns a subscription. Missing rows map to ErrWebhookNotFound.
+func (webhookRepo *WebhookRepo) GetSubscription(ctx context.Context, id uuid.UUID) (*domain.WebhookSubscription, error) {
+	s, err := clientFrom(ctx, webhookRepo.client).WebhookSubscription.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrWebhookNotFound
+		}
+		return nil, err
+	}
+
+	sub := toDomainSubscription(s)
+	return &sub, nil
+}
+
+// ListSubscriptions returns all subscriptions ordered by created_at, id.
+func (webhookRepo *WebhookRepo) ListSubscriptions(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	return webhookRepo.listSubscriptions(ctx, false)
+}
+
+// ListActiveSubscriptions returns the active subscriptions ordered by created_at, id.
+func (webhookRepo *WebhookRepo) ListActiveSubscriptions(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	return webhookRepo.listSubscriptions(ctx, true)
+}
+
+func (webhookRepo *WebhookRepo) listSubscriptions(ctx context.Context, activeOnly bool) ([]domain.WebhookSubscription, error) {
+	q := clientFrom(ctx, webhookRepo.client).WebhookSubscription.
+		Query().
+		Order(
+			webhooksubscription.ByCreatedAt(sql.OrderAsc()),
+			webhooksubscription.ByID(sql.OrderAsc()),
+		)
+	if activeOnly {
+		q = q.Where(webhooksubscription.Active(true))
+	}
+
+	rows, err := q.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subs := make([]domain.WebhookSubscription, 0, len(rows))
+	for _, s := range rows {
+		subs = append(subs, toDomainSubscription(s))
+	}
+	return subs, nil
+}
+
+// UpdateSubscription persists the mutable subscription fields.
+func (webhookRepo *WebhookRepo) UpdateSubscription(ctx context.Context, updatedSubscription *domain.WebhookSubscription) error {
+	err := clientFrom(ctx, webhookRepo.client).WebhookSubscription.
+		UpdateOneID(updatedSubscription.ID).
+		SetURL(updatedSubscription.URL).
+		SetEventTypes(eventTypeStrings(updatedSubscription.EventTypes)).
+		SetActive(updatedSubscription.Active).
+		Exec(ctx)
+
+	if ent.IsNotFound(err) {
+		return domain.ErrWebhookNotFound
+	}
+	return err
+}
+
+// DeleteSubscription removes a subscription. Its deliveries are removed by the ON DELETE CASCADE foreign key.
+func (webhookRepo *WebhookRepo) DeleteSubscription(ctx context.Context, id uuid.UUID) error {
+	err := clientFrom(ctx, webhookRepo.client).WebhookSubscription.DeleteOneID(id).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return domain.ErrWebhookNotFound
+	}
+	return err
+}
+
+// CreateDeliveries inserts deliveries in one statement and copies the generated IDs back.
+func (webhookRepo *WebhookRepo) CreateDeliveries(ctx context.Context, deliveries []domain.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	client := clientFrom(ctx, webhookRepo.client)
+	creates := make([]*ent.WebhookDeliveryCreate, 0, len(deliveries))
+	for _, d := range deliveries {
+		creates = append(creates, client.WebhookDelivery.
+			Create().
+			SetSubscriptionID(d.SubscriptionID).
+			SetEventID(d.EventID).
+			SetEventType(string(d.EventType)).
+			SetPayload(d.Payload).
+			SetStatus(webhookdelivery.Status(d.Status)).
+			SetNextAttemptAt(d.NextAttemptAt).
+			SetCreatedAt(d.CreatedAt))
+	}
+
+	created, err := client.WebhookDelivery.CreateBulk(creates...).Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range deliveries {
+		deliveries[i].ID = created[i].ID
+	}
+	return nil
+}
+
+// ClaimDueDeliveries leases due pending deliveries. Each claim is a conditional update on the
+// next_attempt_at that was read, so a row another worker claimed in between is skipped.
+func (webhookRepo *WebhookRepo) ClaimDueDeliveries(
+	ctx context.Context, now time.Time, lease time.Duration, limit int,
+) ([]domain.WebhookDelivery, error) {
+	client := clientFrom(ctx, webhookRepo.client)
+
+	rows, err := client.WebhookDelivery.
+		Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtLTE(now),
+		).
+		Order(
+			webhookdelivery.ByNextAttemptAt(sql.OrderAsc()),
+			webhookdelivery.ByID(sql.OrderAsc()),
+		).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed := make([]domain.WebhookDelivery, 0, len(rows))
+	for _, row := range rows {
+		n, err := client.WebhookDelivery.
+			Update().
+			Where(
+				webhookdelivery.ID(row.ID),
+				webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+				webhookdelivery.NextAttemptAt(row.NextAttemptAt),
+			).
+			SetNextAttemptAt(now.Add(lease)).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if n == 1 {
+			claimed = append(claimed, toDomainDelivery(row))
+		}
+	}
+	return claimed, nil
+}
+
+// UpdateDelivery persists the attempt bookkeeping of a delivery.
+func (webhookRepo *WebhookRepo) UpdateDelivery(ctx context.Context, d *domain.WebhookDelivery) error {
+	upd := clientFrom(ctx, webhookRepo.client).WebhookDelivery.
+		UpdateOneID(d.ID).
+		SetStatus(webhookdelivery.Status(d.Status)).
+		SetAttempts(d.Attempts).
+		SetNextAttemptAt(d.NextAttemptAt).
+		SetLastError(d.LastError).
+		SetNillableDeliveredAt(d.DeliveredAt)
+
+	// A failed request has no status code; don't keep the one of an earlier attempt.
+	if d.LastStatusCode != nil {
+		upd = upd.SetLastStatusCode(*d.LastStatusCode)
+	} else {
+		upd = upd.ClearLastStatusCode()
+	}
+	return upd.Exec(ctx)
+}
+
+// ListDeliveriesKeyset returns a subscription's deliveries newest first using keyset pagination.
+func (webhookRepo *WebhookRepo) ListDeliveriesKeyset(
+	ctx context.Context, subscriptionID uuid.UUID, limit int, after string,
+) ([]domain.WebhookDelivery, *string, error) {
+	limit = boundLimit(limit)
+
+	q := clientFrom(ctx, webhookRepo.client).WebhookDelivery.
+		Query().
+		Where(webhookdelivery.SubscriptionID(subscriptionID)).
+		// Deterministic total order, newest first: created_at DESC, id DESC
+		Order(
+			webhookdelivery.ByCreatedAt(sql.OrderDesc()),
+			webhookdelivery.ByID(sql.OrderDesc()),
+		)
+
+	// Seek to < (created_at,id) if a cursor was provided.
+	if after != "" {
+		cur, err := webhookRepo.cursors.decode(after, deliveriesScope(subscriptionID))
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", domain.ErrBadCursor, err)
+		}
+		q = q.Where(
+			webhookdelivery.Or(
+				webhookdelivery.CreatedAtLT(cur.T),
+				webhookdelivery.And(
+					webhookdelivery.CreatedAtEQ(cur.T),
+					webhookdelivery.IDLT(cur.I),
+				),
+			),
+		)
+	}
+
+	// Pull one extra to know if there's another page.
+	rows, err := q.Limit(limit + 1).All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nextAfter *string
+	if len(rows) > limit {
+		last := rows[limit-1]
+		cstr, err := webhookRepo.cursors.encode(ksCursor{T: last.CreatedAt, I: last.ID}, deliveriesScope(subscriptionID))
+		if err != nil {
+			return nil, nil, err
+		}
+		nextAfter = &cstr
+		rows = rows[:limit]
+	}
+
+	deliveries := make([]domain.WebhookDelivery, 0, len(rows))
+	for _, row := range rows {
+		deliveries = append(deliveries, toDomainDelivery(row))
+	}
+	return deliveries, nextAfter, nil
+}
+
+// toDomainSubscription maps an ent subscription to the domain model.
+func toDomainSubscription(s *ent.WebhookSubscription) domain.WebhookSubscription {
+	eventTypes := make([]domain.WebhookEventType, 0, len(s.EventTypes))
+	for _, t := range s.EventTypes {
+		eventTypes = append(eventTypes, domain.WebhookEventType(t))
+	}
+	return domain.WebhookSubscription{
+		ID:         s.ID,
+		URL:        s.URL,
+		Secret:     s.Secret,
+		EventTypes: eventTypes,
+		Active:     s.Active,
+		CreatedAt:  s.CreatedAt,
+	}
+}
+
+// toDomainDelivery maps an ent delivery to the domain model.
+func toDomainDelivery(d *ent.WebhookDelivery) domain.WebhookDelivery {
+	return domain.WebhookDelivery{
+		ID:             d.ID,
+		SubscriptionID: d.SubscriptionID,
+		EventID:        d.EventID,
+		EventType:      domain.WebhookEventType(d.EventType),
+		Payload:        d.Payload,
+		Status:         domain.DeliveryStatus(d.Status),
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt,
+		LastStatusCode: d.LastStatusCode,
+		LastError:      d.LastError,
+		DeliveredAt:    d.DeliveredAt,
+		CreatedAt:      d.CreatedAt,
+	}
+}
+
+// eventTypeStrings converts event types for storage.
+func eventTypeStrings(eventTypes []domain.WebhookEventType) []string {
+	out := make([]string, 0, len(eventTypes))
+	for _, t := range eventTypes {
+		out = append(out, string(t))
+	}
+	return out
+}
@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+)
+
+// RequireAdmin only lets requests carrying "Authorization: Bearer <token>" through.
+// With an empty token admin endpoints are disabled.
+func RequireAdmin(token string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			if token == "" {
+				WriteJsonError(writer, "admin access is not configured", http.StatusForbidden)
+				return
+			}
+			given, found := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+			if !found || subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+				writer.Header().Set("WWW-Authenticate", "Bearer")
+				WriteJsonError(writer, "admin token required", http.StatusUnauthorized)
+				return
+			}
+			next.ServeHTTP(writer, req)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRequireAdmin(t *testing.T) {
+	tests := []struct {
+		name          string
+		token         string
+		authorization string
+		wantStatus    int
+	}{
+		{name: "disabled", authorization: "Bearer ", wantStatus: http.StatusForbidden},
+		{name: "missing", token: "s3cret", wantStatus: http.StatusUnauthorized},
+		{name: "wrong", token: "s3cret", authorization: "Bearer other", wantStatus: http.StatusUnauthorized},
+		{name: "not a bearer token", token: "s3cret", authorization: "Basic s3cret", wantStatus: http.StatusUnauthorized},
+		{name: "valid", token: "s3cret", authorization: "Bearer s3cret", wantStatus: http.StatusNoContent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+				writer.WriteHeader(http.StatusNoContent)
+			})
+			req := httptest.NewRequest(http.MethodGet, "/debug/vars", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+
+			RequireAdmin(tt.token)(next).ServeHTTP(rec, req)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("RequireAdmin(%q) with %q = %d, want %d", tt.token, tt.authorization, rec.Code, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -1,12 +1,14 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
 
 	"github.com/SokratisChaimanas/platform-go-challenge/internal/app"
 	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/ports"
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 )
@@ -20,17 +22,168 @@ func NewAssetHandler(assetService *app.AssetService) *AssetHandler {
 	return &AssetHandler{svc: assetService}
 }
 
+// List godoc
+// @Summary      List assets
+// @Description  Returns the asset catalogue using keyset pagination, optionally filtered by type,
+// @Description  creation time range and a full-text search over the description.
+// @Tags         assets
+// @Accept       json
+// @Produce      json
+// @Param        asset_type    query  string  false  "Asset type"  Enums(chart, insight, audience)
+// @Param        created_from  query  string  false  "Created at or after (RFC3339)"
+// @Param        created_to    query  string  false  "Created before (RFC3339)"
+// @Param        q             query  string  false  "Full-text search over description"
+// @Param        limit         query  int     false  "Max items to return (default 20, max 50)"
+// @Param        after         query  string  false  "Opaque cursor from next_after"
+// @Success      200           {object}  handlers.AssetCatalogueResponse
+// @Failure      400           {object}  handlers.ErrorResponse
+// @Failure      500           {object}  handlers.ErrorResponse
+// @Router       /assets [get]
+func (handler *AssetHandler) List(writer http.ResponseWriter, req *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	limit, _, ok := parsePagination(writer, req)
+	if !ok {
+		return
+	}
+	createdFrom, ok := parseTimeQuery(writer, req, "created_from")
+	if !ok {
+		return
+	}
+	createdTo, ok := parseTimeQuery(writer, req, "created_to")
+	if !ok {
+		return
+	}
+
+	q := req.URL.Query()
+	filter := ports.AssetFilter{
+		Type:        domain.AssetType(q.Get("asset_type")),
+		CreatedFrom: createdFrom,
+		CreatedTo:   createdTo,
+		Query:       q.Get("q"),
+	}
+
+	items, nextAfter, err := handler.svc.List(req.Context(), filter, limit, q.Get("after"))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidAssetType):
+			WriteJsonError(writer, "invalid asset_type", http.StatusBadRequest)
+			return
+		case errors.Is(err, domain.ErrInvalidTimeRange):
+			WriteJsonError(writer, "created_from must be before created_to", http.StatusBadRequest)
+			return
+		case errors.Is(err, domain.ErrBadCursor):
+			WriteJsonError(writer, "bad cursor", http.StatusBadRequest)
+			return
+		default:
+			WriteJsonError(writer, "internal error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	out := make([]AssetResponse, 0, len(items))
+	for _, a := range items {
+		out = append(out, newAssetResponse(a))
+	}
+
+	_ = json.NewEncoder(writer).Encode(AssetCatalogueResponse{
+		Items:     out,
+		NextAfter: nextAfter,
+	})
+}
+
+// Get godoc
+// @Summary      Get asset
+// @Description  Returns an asset by ID.
+// @Tags         assets
+// @Accept       json
+// @Produce      json
+// @Param        asset_id  path      string  true  "Asset ID (UUID)"
+// @Success      200       {object}  handlers.AssetResponse
+// @Failure      400       {object}  handlers.ErrorResponse
+// @Failure      404       {object}  handlers.ErrorResponse
+// @Failure      500       {object}  handlers.ErrorResponse
+// @Router       /assets/{asset_id} [get]
+func (handler *AssetHandler) Get(writer http.ResponseWriter, req *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	id, ok := parseUUIDParam(writer, req, "asset_id")
+	if !ok {
+		return
+	}
+
+	a, err := handler.svc.Get(req.Context(), id)
+	if err != nil {
+		if errors.Is(err, domain.ErrAssetNotFound) {
+			WriteJsonError(writer, "asset not found", http.StatusNotFound)
+			return
+		}
+
+		WriteJsonError(writer, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	writer.Header().Set("ETag", assetETag(a))
+	_ = json.NewEncoder(writer).Encode(newAssetResponse(*a))
+}
+
+// Create godoc
+// @Summary      Create asset
+// @Description  Creates a chart, insight or audience asset. The payload shape is validated per type:
+// @Description  chart needs a title plus x/y or series/values, insight needs text, audience needs demographic keys.
+// @Tags         assets
+// @Accept       json
+// @Produce      json
+// @Param        payload  body     handlers.AssetCreateRequest  true  "Asset to create"
+// @Success      201      {object} handlers.AssetResponse
+// @Failure      400      {object} handlers.ErrorResponse
+// @Failure      500      {object} handlers.ErrorResponse
+// @Router       /assets [post]
+func (handler *AssetHandler) Create(writer http.ResponseWriter, req *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	var body AssetCreateRequest
+
+	dec := json.NewDecoder(req.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&body); err != nil {
+		WriteJsonError(writer, "invalid json", http.StatusBadRequest)
+		return
+	}
+
+	payload, err := body.Payload.toDomain(body.Type)
+	if err != nil {
+		writeAssetValidationError(writer, err)
+		return
+	}
+
+	a, err := handler.svc.Create(req.Context(), body.Type, body.Description, payload)
+	if err != nil {
+		writeAssetValidationError(writer, err)
+		return
+	}
+
+	writer.Header().Set("ETag", assetETag(a))
+	writer.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(writer).Encode(newAssetResponse(*a))
+}
+
 // EditDescription godoc
 // @Summary      Edit asset description
-// @Description  Updates the description of an asset.
+// @Description  Updates the description of an asset and records the change in its revision history.
+// @Description  Send the asset ETag in If-Match to reject the edit with 412 if the asset changed meanwhile.
 // @Tags         assets
 // @Accept       json
 // @Produce      json
 // @Param        asset_id  path   string                       true  "Asset ID (UUID)"
+// @Param        X-User-ID header string                       false "Editor user ID (UUID), recorded in the revision history"
+// @Param        If-Match  header string                       false "ETag of the asset version being edited"
 // @Param        payload   body   handlers.AssetEditRequest     true  "New description payload"
 // @Success      200       {object} handlers.AssetResponse
 // @Failure      400       {object} handlers.ErrorResponse
 // @Failure      404       {object} handlers.ErrorResponse
+// @Failure      409       {object} handlers.ErrorResponse
+// @Failure      412       {object} handlers.ErrorResponse
 // @Failure      500       {object} handlers.ErrorResponse
 // @Router       /assets/{asset_id}/description [patch]
 func (handler *AssetHandler) EditDescription(writer http.ResponseWriter, req *http.Request) {
@@ -43,6 +196,15 @@ func (handler *AssetHandler) EditDescription(writer http.ResponseWriter, req *ht
 		return
 	}
 
+	editorID, ok := parseActorHeader(writer, req)
+	if !ok {
+		return
+	}
+	expectedVersions, ok := parseIfMatch(writer, req)
+	if !ok {
+		return
+	}
+
 	var body struct {
 		Description string `json:"description"`
 	}
@@ -54,7 +216,7 @@ func (handler *AssetHandler) EditDescription(writer http.ResponseWriter, req *ht
 		return
 	}
 
-	a, err := handler.svc.EditDescription(req.Context(), id, body.Description)
+	a, err := handler.svc.EditDescription(req.Context(), id, body.Description, editorID, expectedVersions)
 	if err != nil {
 		switch {
 		case errors.Is(err, domain.ErrAssetNotFound):
@@ -63,16 +225,133 @@ func (handler *AssetHandler) EditDescription(writer http.ResponseWriter, req *ht
 		case errors.Is(err, domain.ErrEmptyDescription):
 			WriteJsonError(writer, "description cannot be empty", http.StatusBadRequest)
 			return
+		case errors.Is(err, domain.ErrAssetArchived):
+			WriteJsonError(writer, "asset is archived", http.StatusConflict)
+			return
+		case errors.Is(err, domain.ErrAssetVersionMismatch):
+			WriteJsonError(writer, "asset was modified, reload and retry", http.StatusPreconditionFailed)
+			return
 		default:
 			WriteJsonError(writer, "internal error", http.StatusInternalServerError)
 			return
 		}
 	}
 
-	_ = json.NewEncoder(writer).Encode(AssetResponse{
-		ID:          a.ID,
-		Type:        a.Type,
-		Description: a.Description,
-		Payload:     a.Payload,
-	})
+	writer.Header().Set("ETag", assetETag(a))
+	_ = json.NewEncoder(writer).Encode(newAssetResponse(*a))
+}
+
+// Archive godoc
+// @Summary      Archive asset
+// @Description  Soft-deletes an asset. Archived assets are hidden from listings (including favourites)
+// @Description  and cannot be edited or favourited; existing favourites are kept until the asset is restored or deleted.
+// @Description  Archiving an archived asset is a no-op and keeps its ETag.
+// @Tags         assets
+// @Accept       json
+// @Produce      json
+// @Param        asset_id  path      string  true  "Asset ID (UUID)"
+// @Success      200       {object}  handlers.AssetResponse
+// @Failure      400       {object}  handlers.ErrorResponse
+// @Failure      404       {object}  handlers.ErrorResponse
+// @Failure      409       {object}  handlers.ErrorResponse
+// @Failure      500       {object}  handlers.ErrorResponse
+// @Router       /assets/{asset_id}/archive [post]
+func (handler *AssetHandler) Archive(writer http.ResponseWriter, req *http.Request) {
+	handler.changeArchiveState(writer, req, handler.svc.Archive)
+}
+
+// Restore godoc
+// @Summary      Restore asset
+// @Description  Un-archives an asset, making it and its favourites visible again. Restoring an active asset is a no-op.
+// @Tags         assets
+// @Accept       json
+// @Produce      json
+// @Param        asset_id  path      string  true  "Asset ID (UUID)"
+// @Success      200       {object}  handlers.AssetResponse
+// @Failure      400       {object}  handlers.ErrorResponse
+// @Failure      404       {object}  handlers.ErrorResponse
+// @Failure      409       {object}  handlers.ErrorResponse
+// @Failure      500       {object}  handlers.ErrorResponse
+// @Router       /assets/{asset_id}/restore [post]
+func (handler *AssetHandler) Restore(writer http.ResponseWriter, req *http.Request) {
+	handler.changeArchiveState(writer, req, handler.svc.Restore)
+}
+
+// changeArchiveState runs an archive/restore use case and writes the updated asset.
+func (handler *AssetHandler) changeArchiveState(
+	writer http.ResponseWriter,
+	req *http.Request,
+	change func(ctx context.Context, assetID uuid.UUID) (*domain.Asset, error),
+) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	id, ok := parseUUIDParam(writer, req, "asset_id")
+	if !ok {
+		return
+	}
+
+	a, err := change(req.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrAssetNotFound):
+			WriteJsonError(writer, "asset not found", http.StatusNotFound)
+			return
+		case errors.Is(err, domain.ErrAssetVersionMismatch):
+			// No precondition was sent: the asset kept changing while being retried.
+			WriteJsonError(writer, "asset is being modified concurrently, retry", http.StatusConflict)
+			return
+		default:
+			WriteJsonError(writer, "internal error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	writer.Header().Set("ETag", assetETag(a))
+	_ = json.NewEncoder(writer).Encode(newAssetResponse(*a))
+}
+
+// Delete godoc
+// @Summary      Delete asset
+// @Description  Permanently deletes an asset. Its favourites are removed as well.
+// @Tags         assets
+// @Accept       json
+// @Produce      json
+// @Param        asset_id  path   string  true  "Asset ID (UUID)"
+// @Success      204
+// @Failure      400       {object} handlers.ErrorResponse
+// @Failure      404       {object} handlers.ErrorResponse
+// @Failure      500       {object} handlers.ErrorResponse
+// @Router       /assets/{asset_id} [delete]
+func (handler *AssetHandler) Delete(writer http.ResponseWriter, req *http.Request) {
+	id, ok := parseUUIDParam(writer, req, "asset_id")
+	if !ok {
+		return
+	}
+
+	if err := handler.svc.Delete(req.Context(), id); err != nil {
+		if errors.Is(err, domain.ErrAssetNotFound) {
+			WriteJsonError(writer, "asset not found", http.StatusNotFound)
+			return
+		}
+
+		WriteJsonError(writer, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	writer.WriteHeader(http.StatusNoContent)
+}
+
+// writeAssetValidationError maps asset validation errors to 400 responses,
+// anything else to a 500.
+func writeAssetValidationError(writer http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrInvalidAssetType):
+		WriteJsonError(writer, "invalid asset type", http.StatusBadRequest)
+	case errors.Is(err, domain.ErrEmptyDescription):
+		WriteJsonError(writer, "description cannot be empty", http.StatusBadRequest)
+	case errors.Is(err, domain.ErrInvalidPayload):
+		WriteJsonError(writer, err.Error(), http.StatusBadRequest)
+	default:
+		WriteJsonError(writer, "internal error", http.StatusInternalServerError)
+	}
 }
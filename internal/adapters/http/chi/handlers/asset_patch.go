@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/app"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+)
+
+// Patch media types accepted by PATCH /api/assets/{asset_id}.
+const (
+	mergePatchContentType = "application/merge-patch+json" // RFC 7396
+	jsonPatchContentType  = "application/json-patch+json"  // RFC 6902
+)
+
+// patchKinds maps the patch media types to the patch kinds of the asset service.
+var patchKinds = map[string]app.PatchKind{
+	mergePatchContentType: app.MergePatch,
+	jsonPatchContentType:  app.JSONPatch,
+}
+
+// maxPatchBytes bounds the size of a patch document.
+const maxPatchBytes = 1 << 20
+
+// Patch godoc
+// @Summary      Patch asset
+// @Description  Patches the description and payload of an asset with a JSON Merge Patch (RFC 7396,
+// @Description  application/merge-patch+json) or a JSON Patch (RFC 6902, application/json-patch+json).
+// @Description  The patch applies to { description, payload } as in handlers.AssetResponse; the result is re-validated by the
+// @Description  domain rules before saving. Send the asset ETag in If-Match to reject stale patches with 412.
+// @Tags         assets
+// @Accept       application/merge-patch+json
+// @Accept       application/json-patch+json
+// @Produce      json
+// @Param        asset_id   path    string  true   "Asset ID (UUID)"
+// @Param        X-User-ID  header  string  false  "Editor user ID (UUID), recorded in the revision history"
+// @Param        If-Match   header  string  false  "ETag of the asset version being patched"
+// @Param        patch      body    object  true   "Merge patch object or JSON Patch operations array"
+// @Success      200        {object}  handlers.AssetResponse
+// @Failure      400        {object}  handlers.ErrorResponse
+// @Failure      404        {object}  handlers.ErrorResponse
+// @Failure      409        {object}  handlers.ErrorResponse
+// @Failure      412        {object}  handlers.ErrorResponse
+// @Failure      415        {object}  handlers.ErrorResponse
+// @Failure      500        {object}  handlers.ErrorResponse
+// @Router       /assets/{asset_id} [patch]
+func (handler *AssetHandler) Patch(writer http.ResponseWriter, req *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	id, ok := parseUUIDParam(writer, req, "asset_id")
+	if !ok {
+		return
+	}
+	editorID, ok := parseActorHeader(writer, req)
+	if !ok {
+		return
+	}
+	expectedVersions, ok := parseIfMatch(writer, req)
+	if !ok {
+		return
+	}
+
+	kind, ok := patchKinds[mediaType(req.Header.Get("Content-Type"))]
+	if !ok {
+		WriteJsonError(writer, "content type must be "+mergePatchContentType+" or "+jsonPatchContentType, http.StatusUnsupportedMediaType)
+		return
+	}
+	patch, err := io.ReadAll(http.MaxBytesReader(writer, req.Body, maxPatchBytes))
+	if err != nil {
+		WriteJsonError(writer, "invalid patch document", http.StatusBadRequest)
+		return
+	}
+
+	a, err := handler.svc.Patch(req.Context(), id, patch, kind, editorID, expectedVersions)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrAssetNotFound):
+			WriteJsonError(writer, "asset not found", http.StatusNotFound)
+			return
+		case errors.Is(err, domain.ErrAssetArchived):
+			WriteJsonError(writer, "asset is archived", http.StatusConflict)
+			return
+		case errors.Is(err, domain.ErrAssetVersionMismatch):
+			WriteJsonError(writer, "asset was modified, reload and retry", http.StatusPreconditionFailed)
+			return
+		case errors.Is(err, domain.ErrPatchConflict):
+			WriteJsonError(writer, err.Error(), http.StatusConflict)
+			return
+		case errors.Is(err, domain.ErrInvalidPatch):
+			WriteJsonError(writer, err.Error(), http.StatusBadRequest)
+			return
+		default:
+			writeAssetValidationError(writer, err)
+			return
+		}
+	}
+
+	writer.Header().Set("ETag", assetETag(a))
+	_ = json.NewEncoder(writer).Encode(newAssetResponse(*a))
+}
+
+// mediaType returns the media type of a Content-Type header without its parameters.
+func mediaType(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ""
+	}
+	return mediaType
+}
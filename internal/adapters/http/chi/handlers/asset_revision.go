@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+)
+
+// ListRevisions godoc
+// @Summary      List asset revisions
+// @Description  Returns the description edit history of an asset, newest first, using keyset pagination.
+// @Tags         assets
+// @Accept       json
+// @Produce      json
+// @Param        asset_id  path   string  true   "Asset ID (UUID)"
+// @Param        limit     query  int     false  "Max items to return (default 20, max 50)"
+// @Param        after     query  string  false  "Opaque cursor from next_after"
+// @Success      200       {object}  handlers.AssetRevisionsListResponse
+// @Failure      400       {object}  handlers.ErrorResponse
+// @Failure      404       {object}  handlers.ErrorResponse
+// @Failure      500       {object}  handlers.ErrorResponse
+// @Router       /assets/{asset_id}/revisions [get]
+func (handler *AssetHandler) ListRevisions(writer http.ResponseWriter, req *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	assetID, ok := parseUUIDParam(writer, req, "asset_id")
+	if !ok {
+		return
+	}
+
+	limit, _, ok := parsePagination(writer, req)
+	if !ok {
+		return
+	}
+
+	items, nextAfter, err := handler.svc.ListRevisions(req.Context(), assetID, limit, req.URL.Query().Get("after"))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrAssetNotFound):
+			WriteJsonError(writer, "asset not found", http.StatusNotFound)
+			return
+		case errors.Is(err, domain.ErrBadCursor):
+			WriteJsonError(writer, "bad cursor", http.StatusBadRequest)
+			return
+		default:
+			WriteJsonError(writer, "internal error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	out := make([]AssetRevisionResponse, 0, len(items))
+	for _, r := range items {
+		out = append(out, AssetRevisionResponse{
+			ID:             r.ID,
+			AssetID:        r.AssetID,
+			OldDescription: r.OldDescription,
+			NewDescription: r.NewDescription,
+			EditorID:       r.EditorID,
+			CreatedAt:      r.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	_ = json.NewEncoder(writer).Encode(AssetRevisionsListResponse{
+		Items:     out,
+		NextAfter: nextAfter,
+	})
+}
+
+// RevertRevision godoc
+// @Summary      Revert asset revision
+// @Description  Restores the description the asset had before the given revision.
+// @Description  The revert is recorded as a new revision.
+// @Tags         assets
+// @Accept       json
+// @Produce      json
+// @Param        asset_id     path    string  true   "Asset ID (UUID)"
+// @Param        revision_id  path    string  true   "Revision ID (UUID)"
+// @Param        X-User-ID    header  string  false  "Editor user ID (UUID), recorded in the revision history"
+// @Success      200          {object}  handlers.AssetResponse
+// @Failure      400          {object}  handlers.ErrorResponse
+// @Failure      404          {object}  handlers.ErrorResponse
+// @Failure      409          {object}  handlers.ErrorResponse
+// @Failure      412          {object}  handlers.ErrorResponse
+// @Failure      500          {object}  handlers.ErrorResponse
+// @Router       /assets/{asset_id}/revisions/{revision_id}/revert [post]
+func (handler *AssetHandler) RevertRevision(writer http.ResponseWriter, req *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	assetID, ok := parseUUIDParam(writer, req, "asset_id")
+	if !ok {
+		return
+	}
+	revisionID, ok := parseUUIDParam(writer, req, "revision_id")
+	if !ok {
+		return
+	}
+	editorID, ok := parseActorHeader(writer, req)
+	if !ok {
+		return
+	}
+
+	a, err := handler.svc.RevertRevision(req.Context(), assetID, revisionID, editorID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrAssetNotFound):
+			WriteJsonError(writer, "asset not found", http.StatusNotFound)
+			return
+		case errors.Is(err, domain.ErrRevisionNotFound):
+			WriteJsonError(writer, "revision not found", http.StatusNotFound)
+			return
+		case errors.Is(err, domain.ErrAssetArchived):
+			WriteJsonError(writer, "asset is archived", http.StatusConflict)
+			return
+		case errors.Is(err, domain.ErrAssetVersionMismatch):
+			WriteJsonError(writer, "asset was modified, reload and retry", http.StatusPreconditionFailed)
+			return
+		default:
+			WriteJsonError(writer, "internal error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	writer.Header().Set("ETag", assetETag(a))
+	_ = json.NewEncoder(writer).Encode(newAssetResponse(*a))
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/app"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+)
+
+// AuditHandler serves the audit log.
+type AuditHandler struct {
+	auditService *app.AuditService
+}
+
+func NewAuditHandler(auditService *app.AuditService) *AuditHandler {
+	return &AuditHandler{auditService: auditService}
+}
+
+// List godoc
+// @Summary      List audit entries
+// @Description  Returns the recorded asset and favourite changes, newest first, using keyset pagination.
+// @Description  Each entry names the claimed actor (the unverified X-User-ID of the request), the request ID and the row before and after.
+// @Description  Favourite notes are private: snapshots hold note_length, and note edits are flagged with note_changed.
+// @Description  Admins only: send the configured admin token as "Authorization: Bearer <token>".
+// @Tags         audit
+// @Accept       json
+// @Produce      json
+// @Security     AdminToken
+// @Param        claimed_actor_id  query  string  false  "Claimed actor (user) ID (UUID)"
+// @Param        asset_id    query  string  false  "Asset ID (UUID); includes the asset's favourites"
+// @Param        user_id     query  string  false  "Favourite owner ID (UUID)"
+// @Param        action      query  string  false  "Action"  Enums(asset.created, asset.updated, asset.deleted, favourite.created, favourite.updated, favourite.deleted)
+// @Param        request_id  query  string  false  "Request ID (X-Request-Id)"
+// @Param        from        query  string  false  "At or after (RFC3339)"
+// @Param        to          query  string  false  "Before (RFC3339)"
+// @Param        limit       query  int     false  "Max items to return (default 20, max 50)"
+// @Param        after       query  string  false  "Opaque cursor from next_after"
+// @Success      200         {object}  handlers.AuditListResponse
+// @Failure      400         {object}  handlers.ErrorResponse
+// @Failure      401         {object}  handlers.ErrorResponse
+// @Failure      403         {object}  handlers.ErrorResponse
+// @Failure      500         {object}  handlers.ErrorResponse
+// @Router       /audit [get]
+func (handler *AuditHandler) List(writer http.ResponseWriter, req *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	limit, _, ok := parsePagination(writer, req)
+	if !ok {
+		return
+	}
+
+	q := req.URL.Query()
+	filter := domain.AuditFilter{
+		Action:    domain.AuditAction(q.Get("action")),
+		RequestID: q.Get("request_id"),
+	}
+	if filter.ClaimedActorID, ok = parseUUIDQuery(writer, req, "claimed_actor_id"); !ok {
+		return
+	}
+	if filter.AssetID, ok = parseUUIDQuery(writer, req, "asset_id"); !ok {
+		return
+	}
+	if filter.UserID, ok = parseUUIDQuery(writer, req, "user_id"); !ok {
+		return
+	}
+	if filter.From, ok = parseTimeQuery(writer, req, "from"); !ok {
+		return
+	}
+	if filter.To, ok = parseTimeQuery(writer, req, "to"); !ok {
+		return
+	}
+
+	items, nextAfter, err := handler.auditService.List(req.Context(), filter, limit, q.Get("after"))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidAuditFilter):
+			WriteJsonError(writer, err.Error(), http.StatusBadRequest)
+		case errors.Is(err, domain.ErrInvalidTimeRange):
+			WriteJsonError(writer, "from must be before to", http.StatusBadRequest)
+		case errors.Is(err, domain.ErrBadCursor):
+			WriteJsonError(writer, "bad cursor", http.StatusBadRequest)
+		default:
+			WriteJsonError(writer, "internal error", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	out := make([]AuditEntryResponse, 0, len(items))
+	for _, e := range items {
+		out = append(out, AuditEntryResponse{
+			ID:             e.ID,
+			At:             e.At.UTC().Format(time.RFC3339Nano),
+			ClaimedActorID: e.ClaimedActorID,
+			RequestID:      e.RequestID,
+			Action:         string(e.Action),
+			EntityType:     e.EntityType,
+			EntityID:       e.EntityID,
+			AssetID:        e.AssetID,
+			UserID:         e.UserID,
+			Before:         e.Before,
+			After:          e.After,
+		})
+	}
+
+	_ = json.NewEncoder(writer).Encode(AuditListResponse{Items: out, NextAfter: nextAfter})
+}
@@ -0,0 +1,298 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/app"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/google/uuid"
+)
+
+// CollectionHandler serves collection endpoints.
+type CollectionHandler struct {
+	collectionService *app.CollectionService
+}
+
+func NewCollectionHandler(collectionService *app.CollectionService) *CollectionHandler {
+	return &CollectionHandler{collectionService: collectionService}
+}
+
+// List godoc
+// @Summary      List collections
+// @Description  Returns all collections of a user.
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        user_id  path      string  true  "User ID (UUID)"
+// @Success      200      {object}  handlers.CollectionsListResponse
+// @Failure      400      {object}  handlers.ErrorResponse
+// @Failure      404      {object}  handlers.ErrorResponse
+// @Failure      500      {object}  handlers.ErrorResponse
+// @Router       /users/{user_id}/collections [get]
+func (handler *CollectionHandler) List(writer http.ResponseWriter, req *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	userID, ok := parseUUIDParam(writer, req, "user_id")
+	if !ok {
+		return
+	}
+
+	items, err := handler.collectionService.List(req.Context(), userID)
+	if err != nil {
+		writeCollectionError(writer, err)
+		return
+	}
+
+	out := make([]CollectionResponse, 0, len(items))
+	for _, c := range items {
+		out = append(out, newCollectionResponse(c))
+	}
+
+	_ = json.NewEncoder(writer).Encode(CollectionsListResponse{Items: out})
+}
+
+// Create godoc
+// @Summary      Create collection
+// @Description  Creates a collection for organising the user's favourites. Names are unique per user.
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        user_id  path     string                      true  "User ID (UUID)"
+// @Param        payload  body     handlers.CollectionRequest  true  "Collection payload"
+// @Success      201      {object} handlers.CollectionResponse
+// @Failure      400      {object} handlers.ErrorResponse
+// @Failure      404      {object} handlers.ErrorResponse
+// @Failure      409      {object} handlers.ErrorResponse
+// @Failure      500      {object} handlers.ErrorResponse
+// @Router       /users/{user_id}/collections [post]
+func (handler *CollectionHandler) Create(writer http.ResponseWriter, req *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	userID, ok := parseUUIDParam(writer, req, "user_id")
+	if !ok {
+		return
+	}
+
+	var body CollectionRequest
+
+	dec := json.NewDecoder(req.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&body); err != nil {
+		WriteJsonError(writer, "invalid json", http.StatusBadRequest)
+		return
+	}
+
+	c, err := handler.collectionService.Create(req.Context(), userID, body.Name)
+	if err != nil {
+		writeCollectionError(writer, err)
+		return
+	}
+
+	writer.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(writer).Encode(newCollectionResponse(c))
+}
+
+// Get godoc
+// @Summary      Get collection
+// @Description  Returns one of the user's collections. List its favourites with GET /users/{user_id}/favourites?collection_id=.
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        user_id        path      string  true  "User ID (UUID)"
+// @Param        collection_id  path      string  true  "Collection ID (UUID)"
+// @Success      200            {object}  handlers.CollectionResponse
+// @Failure      400            {object}  handlers.ErrorResponse
+// @Failure      404            {object}  handlers.ErrorResponse
+// @Failure      500            {object}  handlers.ErrorResponse
+// @Router       /users/{user_id}/collections/{collection_id} [get]
+func (handler *CollectionHandler) Get(writer http.ResponseWriter, req *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	userID, ok := parseUUIDParam(writer, req, "user_id")
+	if !ok {
+		return
+	}
+	collectionID, ok := parseUUIDParam(writer, req, "collection_id")
+	if !ok {
+		return
+	}
+
+	c, err := handler.collectionService.Get(req.Context(), userID, collectionID)
+	if err != nil {
+		writeCollectionError(writer, err)
+		return
+	}
+
+	_ = json.NewEncoder(writer).Encode(newCollectionResponse(*c))
+}
+
+// Rename godoc
+// @Summary      Rename collection
+// @Description  Changes the name of one of the user's collections.
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        user_id        path     string                      true  "User ID (UUID)"
+// @Param        collection_id  path     string                      true  "Collection ID (UUID)"
+// @Param        payload        body     handlers.CollectionRequest  true  "Collection payload"
+// @Success      200            {object} handlers.CollectionResponse
+// @Failure      400            {object} handlers.ErrorResponse
+// @Failure      404            {object} handlers.ErrorResponse
+// @Failure      409            {object} handlers.ErrorResponse
+// @Failure      500            {object} handlers.ErrorResponse
+// @Router       /users/{user_id}/collections/{collection_id} [patch]
+func (handler *CollectionHandler) Rename(writer http.ResponseWriter, req *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	userID, ok := parseUUIDParam(writer, req, "user_id")
+	if !ok {
+		return
+	}
+	collectionID, ok := parseUUIDParam(writer, req, "collection_id")
+	if !ok {
+		return
+	}
+
+	var body CollectionRequest
+
+	dec := json.NewDecoder(req.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&body); err != nil {
+		WriteJsonError(writer, "invalid json", http.StatusBadRequest)
+		return
+	}
+
+	c, err := handler.collectionService.Rename(req.Context(), userID, collectionID, body.Name)
+	if err != nil {
+		writeCollectionError(writer, err)
+		return
+	}
+
+	_ = json.NewEncoder(writer).Encode(newCollectionResponse(*c))
+}
+
+// Delete godoc
+// @Summary      Delete collection
+// @Description  Deletes one of the user's collections. The favourites it held are kept.
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        user_id        path   string  true  "User ID (UUID)"
+// @Param        collection_id  path   string  true  "Collection ID (UUID)"
+// @Success      204
+// @Failure      400            {object} handlers.ErrorResponse
+// @Failure      404            {object} handlers.ErrorResponse
+// @Failure      500            {object} handlers.ErrorResponse
+// @Router       /users/{user_id}/collections/{collection_id} [delete]
+func (handler *CollectionHandler) Delete(writer http.ResponseWriter, req *http.Request) {
+	userID, ok := parseUUIDParam(writer, req, "user_id")
+	if !ok {
+		return
+	}
+	collectionID, ok := parseUUIDParam(writer, req, "collection_id")
+	if !ok {
+		return
+	}
+
+	if err := handler.collectionService.Delete(req.Context(), userID, collectionID); err != nil {
+		writeCollectionError(writer, err)
+		return
+	}
+
+	writer.WriteHeader(http.StatusNoContent)
+}
+
+// AddFavourite godoc
+// @Summary      Add favourite to collection
+// @Description  Puts an already favourited asset into one of the user's collections. Adding twice is a no-op.
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        user_id        path   string  true  "User ID (UUID)"
+// @Param        collection_id  path   string  true  "Collection ID (UUID)"
+// @Param        asset_id       path   string  true  "Asset ID (UUID)"
+// @Success      204
+// @Failure      400            {object} handlers.ErrorResponse
+// @Failure      404            {object} handlers.ErrorResponse
+// @Failure      500            {object} handlers.ErrorResponse
+// @Router       /users/{user_id}/collections/{collection_id}/favourites/{asset_id} [put]
+func (handler *CollectionHandler) AddFavourite(writer http.ResponseWriter, req *http.Request) {
+	handler.changeMembership(writer, req, handler.collectionService.AddFavourite)
+}
+
+// RemoveFavourite godoc
+// @Summary      Remove favourite from collection
+// @Description  Takes a favourited asset out of one of the user's collections. The favourite itself is kept.
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        user_id        path   string  true  "User ID (UUID)"
+// @Param        collection_id  path   string  true  "Collection ID (UUID)"
+// @Param        asset_id       path   string  true  "Asset ID (UUID)"
+// @Success      204
+// @Failure      400            {object} handlers.ErrorResponse
+// @Failure      404            {object} handlers.ErrorResponse
+// @Failure      500            {object} handlers.ErrorResponse
+// @Router       /users/{user_id}/collections/{collection_id}/favourites/{asset_id} [delete]
+func (handler *CollectionHandler) RemoveFavourite(writer http.ResponseWriter, req *http.Request) {
+	handler.changeMembership(writer, req, handler.collectionService.RemoveFavourite)
+}
+
+// changeMembership runs an add/remove membership use case and writes 204 on success.
+func (handler *CollectionHandler) changeMembership(
+	writer http.ResponseWriter,
+	req *http.Request,
+	change func(ctx context.Context, userID, collectionID, assetID uuid.UUID) error,
+) {
+	userID, ok := parseUUIDParam(writer, req, "user_id")
+	if !ok {
+		return
+	}
+	collectionID, ok := parseUUIDParam(writer, req, "collection_id")
+	if !ok {
+		return
+	}
+	assetID, ok := parseUUIDParam(writer, req, "asset_id")
+	if !ok {
+		return
+	}
+
+	if err := change(req.Context(), userID, collectionID, assetID); err != nil {
+		writeCollectionError(writer, err)
+		return
+	}
+
+	writer.WriteHeader(http.StatusNoContent)
+}
+
+// writeCollectionError maps collection use case errors to HTTP responses.
+func writeCollectionError(writer http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrUserNotFound):
+		WriteJsonError(writer, "user not found", http.StatusNotFound)
+	case errors.Is(err, domain.ErrCollectionNotFound):
+		WriteJsonError(writer, "collection not found", http.StatusNotFound)
+	case errors.Is(err, domain.ErrFavouriteNotFound):
+		WriteJsonError(writer, "favourite not found", http.StatusNotFound)
+	case errors.Is(err, domain.ErrInvalidCollectionName):
+		WriteJsonError(writer, "collection name must be 1-100 characters", http.StatusBadRequest)
+	case errors.Is(err, domain.ErrCollectionAlreadyExists):
+		WriteJsonError(writer, "collection already exists", http.StatusConflict)
+	default:
+		WriteJsonError(writer, "internal error", http.StatusInternalServerError)
+	}
+}
+
+// newCollectionResponse maps a domain collection to its response shape.
+func newCollectionResponse(c domain.Collection) CollectionResponse {
+	return CollectionResponse{
+		ID:        c.ID,
+		UserID:    c.UserID,
+		Name:      c.Name,
+		CreatedAt: c.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
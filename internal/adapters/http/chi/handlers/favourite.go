@@ -1,13 +1,16 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
-	"time"
+	"strings"
 
 	"github.com/SokratisChaimanas/platform-go-challenge/internal/app"
 	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/ports"
 	"github.com/google/uuid"
 )
 
@@ -22,20 +25,26 @@ func NewFavouritesHandler(favService *app.FavouritesService) *FavouritesHandler
 
 // ListByUser godoc
 // @Summary      List favourites for a user
-// @Description  Returns assets the user has favourited using keyset pagination.
+// @Description  Returns assets the user has favourited, with the favourite note, using keyset pagination.
+// @Description  A cursor is only valid for the sort it was issued with.
 // @Tags         favourites
 // @Accept       json
 // @Produce      json
 // @Param        user_id  path   string  true   "User ID (UUID)"
 // @Param        limit    query  int     false  "Max items to return (default 20, max 50)"
-// @Param        after    query  string  false  "Opaque cursor from next_after"
+// @Param        after    query  string  false  "Opaque cursor from next_after (next page)"
+// @Param        before   query  string  false  "Opaque cursor from prev_before (previous page)"
+// @Param        collection_id  query  string  false  "Only favourites in this collection (UUID)"
+// @Param        asset_type     query  string  false  "Only assets of this type" Enums(chart, insight, audience)
+// @Param        sort           query  string  false  "Ordering (default created_asc)" Enums(created_asc, created_desc, description)
+// @Param        include        query  string  false  "Set to total to also count all matching favourites" Enums(total)
 // @Success      200      {object}  handlers.AssetsListResponse
 // @Failure      400      {object}  handlers.ErrorResponse
 // @Failure      404      {object}  handlers.ErrorResponse
 // @Failure      500      {object}  handlers.ErrorResponse
 // @Router       /users/{user_id}/favourites [get]
 func (handler *FavouritesHandler) ListByUser(writer http.ResponseWriter, req *http.Request) {
-	// Returns assets + a next_after cursor. Uses keyset pagination, not offset.
+	// Returns assets + next_after / prev_before cursors. Uses keyset pagination, not offset.
 	writer.Header().Set("Content-Type", "application/json")
 
 	userID, ok := parseUUIDParam(writer, req, "user_id")
@@ -50,13 +59,49 @@ func (handler *FavouritesHandler) ListByUser(writer http.ResponseWriter, req *ht
 	}
 
 	after := req.URL.Query().Get("after")
+	before := req.URL.Query().Get("before")
+	if after != "" && before != "" {
+		WriteJsonError(writer, "use either after or before", http.StatusBadRequest)
+		return
+	}
+
+	var filter ports.FavouriteFilter
+	if val := req.URL.Query().Get("collection_id"); val != "" {
+		collectionID, err := uuid.Parse(val)
+		if err != nil {
+			WriteJsonError(writer, "invalid collection_id", http.StatusBadRequest)
+			return
+		}
+		filter.CollectionID = &collectionID
+	}
+	filter.AssetType = domain.AssetType(req.URL.Query().Get("asset_type"))
+	filter.Sort = domain.FavouriteSort(req.URL.Query().Get("sort"))
+
+	// The total costs an extra count query, so clients opt in.
+	var includeTotal bool
+	if val := req.URL.Query().Get("include"); val != "" {
+		if val != "total" {
+			WriteJsonError(writer, "invalid include", http.StatusBadRequest)
+			return
+		}
+		includeTotal = true
+	}
 
-	items, nextAfter, err := handler.favService.ListByUserKeyset(req.Context(), userID, limit, after)
+	items, cursors, err := handler.favService.ListByUserKeyset(req.Context(), userID, filter, limit, after, before)
 	if err != nil {
 		switch {
 		case errors.Is(err, domain.ErrUserNotFound):
 			WriteJsonError(writer, "user not found", http.StatusNotFound)
 			return
+		case errors.Is(err, domain.ErrCollectionNotFound):
+			WriteJsonError(writer, "collection not found", http.StatusNotFound)
+			return
+		case errors.Is(err, domain.ErrInvalidAssetType):
+			WriteJsonError(writer, "invalid asset_type", http.StatusBadRequest)
+			return
+		case errors.Is(err, domain.ErrInvalidSort):
+			WriteJsonError(writer, "invalid sort", http.StatusBadRequest)
+			return
 		case errors.Is(err, domain.ErrBadCursor):
 			WriteJsonError(writer, "bad cursor", http.StatusBadRequest)
 			return
@@ -66,25 +111,185 @@ func (handler *FavouritesHandler) ListByUser(writer http.ResponseWriter, req *ht
 		}
 	}
 
-	out := make([]AssetResponse, 0, len(items))
-	for _, a := range items {
-		out = append(out, AssetResponse{
-			ID:          a.ID,
-			Type:        a.Type,
-			Description: a.Description,
-			Payload:     a.Payload,
-		})
+	out := make([]FavouriteItemResponse, 0, len(items))
+	for _, item := range items {
+		out = append(out, newFavouriteItemResponse(item))
+	}
+
+	resp := AssetsListResponse{
+		Items:      out,
+		NextAfter:  cursors.NextAfter,
+		PrevBefore: cursors.PrevBefore,
+	}
+	if includeTotal {
+		total, err := handler.favService.CountByUser(req.Context(), userID, filter)
+		if err != nil {
+			WriteJsonError(writer, "internal error", http.StatusInternalServerError)
+			return
+		}
+		resp.Total = &total
+	}
+
+	_ = json.NewEncoder(writer).Encode(resp)
+}
+
+// Changes godoc
+// @Summary      Favourites change feed
+// @Description  Returns the user's favourite additions and removals since a sync token, oldest first, for clients
+// @Description  keeping a local cache. Start without since, store next_token and pass it next time; keep calling
+// @Description  while has_more is true. Changes of transactions still in flight are held back until they finish.
+// @Description  410 means the token outlived the removal history: drop the cache and sync from scratch.
+// @Tags         favourites
+// @Accept       json
+// @Produce      json
+// @Param        user_id  path   string  true   "User ID (UUID)"
+// @Param        since    query  string  false  "Sync token from next_token; empty for a full sync"
+// @Param        limit    query  int     false  "Max changes to return (default 20, max 50)"
+// @Success      200      {object} handlers.FavouriteChangesResponse
+// @Failure      400      {object} handlers.ErrorResponse
+// @Failure      404      {object} handlers.ErrorResponse
+// @Failure      410      {object} handlers.ErrorResponse
+// @Failure      500      {object} handlers.ErrorResponse
+// @Router       /users/{user_id}/favourites/changes [get]
+func (handler *FavouritesHandler) Changes(writer http.ResponseWriter, req *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	userID, ok := parseUUIDParam(writer, req, "user_id")
+	if !ok {
+		return
+	}
+	limit, _, ok := parsePagination(writer, req)
+	if !ok {
+		return
+	}
+
+	changes, next, more, err := handler.favService.Changes(req.Context(), userID, req.URL.Query().Get("since"), limit)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound):
+			WriteJsonError(writer, "user not found", http.StatusNotFound)
+			return
+		case errors.Is(err, domain.ErrSyncTokenExpired):
+			WriteJsonError(writer, "sync token expired, sync from scratch", http.StatusGone)
+			return
+		case errors.Is(err, domain.ErrBadCursor):
+			WriteJsonError(writer, "invalid sync token", http.StatusBadRequest)
+			return
+		default:
+			WriteJsonError(writer, "internal error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	_ = json.NewEncoder(writer).Encode(newFavouriteChangesResponse(changes, next, more))
+}
+
+// Get godoc
+// @Summary      Get favourite
+// @Description  Returns the user's favourite of an asset, or 404 when the asset is not favourited.
+// @Description  Favourites of archived assets are hidden.
+// @Tags         favourites
+// @Accept       json
+// @Produce      json
+// @Param        user_id   path   string  true  "User ID (UUID)"
+// @Param        asset_id  path   string  true  "Asset ID (UUID)"
+// @Success      200       {object} handlers.FavouriteResponse
+// @Failure      400       {object} handlers.ErrorResponse
+// @Failure      404       {object} handlers.ErrorResponse
+// @Failure      500       {object} handlers.ErrorResponse
+// @Router       /users/{user_id}/favourites/{asset_id} [get]
+func (handler *FavouritesHandler) Get(writer http.ResponseWriter, req *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	userID, ok := parseUUIDParam(writer, req, "user_id")
+	if !ok {
+		return
+	}
+	assetID, ok := parseUUIDParam(writer, req, "asset_id")
+	if !ok {
+		return
+	}
+
+	f, err := handler.favService.Get(req.Context(), userID, assetID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound):
+			WriteJsonError(writer, "user not found", http.StatusNotFound)
+			return
+		case errors.Is(err, domain.ErrFavouriteNotFound):
+			WriteJsonError(writer, "favourite not found", http.StatusNotFound)
+			return
+		default:
+			WriteJsonError(writer, "internal error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	_ = json.NewEncoder(writer).Encode(newFavouriteResponse(f))
+}
+
+// Status godoc
+// @Summary      Favourite status of many assets
+// @Description  Tells, for up to 100 assets, whether the user has favourited each one and when.
+// @Description  Asset IDs are comma separated and/or repeated; unknown and archived assets are reported as not favourited.
+// @Tags         favourites
+// @Accept       json
+// @Produce      json
+// @Param        user_id    path   string  true  "User ID (UUID)"
+// @Param        asset_ids  query  string  true  "Comma separated asset IDs (UUID)"
+// @Success      200        {object} handlers.FavouriteStatusResponse
+// @Failure      400        {object} handlers.ErrorResponse
+// @Failure      404        {object} handlers.ErrorResponse
+// @Failure      500        {object} handlers.ErrorResponse
+// @Router       /users/{user_id}/favourites/status [get]
+func (handler *FavouritesHandler) Status(writer http.ResponseWriter, req *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	userID, ok := parseUUIDParam(writer, req, "user_id")
+	if !ok {
+		return
+	}
+
+	var assetIDs []uuid.UUID
+	for _, val := range req.URL.Query()["asset_ids"] {
+		for _, raw := range strings.Split(val, ",") {
+			raw = strings.TrimSpace(raw)
+			if raw == "" {
+				continue
+			}
+			assetID, err := uuid.Parse(raw)
+			if err != nil {
+				WriteJsonError(writer, "invalid asset_id: "+raw, http.StatusBadRequest)
+				return
+			}
+			assetIDs = append(assetIDs, assetID)
+		}
+	}
+
+	status, err := handler.favService.Status(req.Context(), userID, assetIDs)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound):
+			WriteJsonError(writer, "user not found", http.StatusNotFound)
+			return
+		case errors.Is(err, domain.ErrEmptyBatch):
+			WriteJsonError(writer, "asset_ids is required", http.StatusBadRequest)
+			return
+		case errors.Is(err, domain.ErrBatchTooLarge):
+			WriteJsonError(writer, fmt.Sprintf("at most %d asset_ids per request", domain.MaxBatchSize), http.StatusBadRequest)
+			return
+		default:
+			WriteJsonError(writer, "internal error", http.StatusInternalServerError)
+			return
+		}
 	}
 
-	_ = json.NewEncoder(writer).Encode(AssetsListResponse{
-		Items:     out,
-		NextAfter: nextAfter,
-	})
+	_ = json.NewEncoder(writer).Encode(newFavouriteStatusResponse(status))
 }
 
 // Add godoc
 // @Summary      Add favourite
-// @Description  Adds an asset to the user's favourites.
+// @Description  Adds an asset to the user's favourites, optionally with a private note.
 // @Tags         favourites
 // @Accept       json
 // @Produce      json
@@ -104,9 +309,7 @@ func (handler *FavouritesHandler) Add(writer http.ResponseWriter, req *http.Requ
 		return
 	}
 
-	var body struct {
-		AssetID string `json:"asset_id"`
-	}
+	var body FavouriteAddRequest
 
 	dec := json.NewDecoder(req.Body)
 	dec.DisallowUnknownFields()
@@ -121,7 +324,7 @@ func (handler *FavouritesHandler) Add(writer http.ResponseWriter, req *http.Requ
 		return
 	}
 
-	f, err := handler.favService.Add(req.Context(), userID, assetID)
+	f, err := handler.favService.Add(req.Context(), userID, assetID, body.Note)
 	if err != nil {
 		switch {
 		case errors.Is(err, domain.ErrUserNotFound):
@@ -133,24 +336,126 @@ func (handler *FavouritesHandler) Add(writer http.ResponseWriter, req *http.Requ
 		case errors.Is(err, domain.ErrFavouriteAlreadyExists):
 			WriteJsonError(writer, "favourite already exists", http.StatusConflict)
 			return
+		case errors.Is(err, domain.ErrAssetArchived):
+			WriteJsonError(writer, "asset is archived", http.StatusConflict)
+			return
+		case errors.Is(err, domain.ErrNoteTooLong):
+			WriteJsonError(writer, "note is too long", http.StatusBadRequest)
+			return
 		default:
 			WriteJsonError(writer, "internal error", http.StatusInternalServerError)
 			return
 		}
 	}
 
-	type resp struct {
-		UserID    uuid.UUID `json:"user_id"`
-		AssetID   uuid.UUID `json:"asset_id"`
-		CreatedAt string    `json:"created_at"`
+	writer.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(writer).Encode(newFavouriteResponse(f))
+}
+
+// Put godoc
+// @Summary      Favourite an asset (idempotent)
+// @Description  Ensures the asset is in the user's favourites. Returns 201 when the favourite was created
+// @Description  and 200 with the existing favourite (note untouched) when it already was, so retries are safe.
+// @Description  An existing favourite is returned even if its asset has been archived since; 409 only refuses new ones.
+// @Tags         favourites
+// @Accept       json
+// @Produce      json
+// @Param        user_id   path   string  true  "User ID (UUID)"
+// @Param        asset_id  path   string  true  "Asset ID (UUID)"
+// @Success      200       {object} handlers.FavouriteResponse
+// @Success      201       {object} handlers.FavouriteResponse
+// @Failure      400       {object} handlers.ErrorResponse
+// @Failure      404       {object} handlers.ErrorResponse
+// @Failure      409       {object} handlers.ErrorResponse
+// @Failure      500       {object} handlers.ErrorResponse
+// @Router       /users/{user_id}/favourites/{asset_id} [put]
+func (handler *FavouritesHandler) Put(writer http.ResponseWriter, req *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	userID, ok := parseUUIDParam(writer, req, "user_id")
+	if !ok {
+		return
+	}
+	assetID, ok := parseUUIDParam(writer, req, "asset_id")
+	if !ok {
+		return
 	}
 
-	writer.WriteHeader(http.StatusCreated)
-	_ = json.NewEncoder(writer).Encode(resp{
-		UserID:    f.UserID,
-		AssetID:   f.AssetID,
-		CreatedAt: f.CreatedAt.UTC().Format(time.RFC3339),
-	})
+	f, created, err := handler.favService.Put(req.Context(), userID, assetID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound):
+			WriteJsonError(writer, "user not found", http.StatusNotFound)
+			return
+		case errors.Is(err, domain.ErrAssetNotFound):
+			WriteJsonError(writer, "asset not found", http.StatusNotFound)
+			return
+		case errors.Is(err, domain.ErrAssetArchived):
+			WriteJsonError(writer, "asset is archived", http.StatusConflict)
+			return
+		default:
+			WriteJsonError(writer, "internal error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	if created {
+		writer.WriteHeader(http.StatusCreated)
+	}
+	_ = json.NewEncoder(writer).Encode(newFavouriteResponse(f))
+}
+
+// EditNote godoc
+// @Summary      Edit favourite note
+// @Description  Sets the private note / custom title of a favourite. An empty note clears it.
+// @Tags         favourites
+// @Accept       json
+// @Produce      json
+// @Param        user_id   path   string                         true  "User ID (UUID)"
+// @Param        asset_id  path   string                         true  "Asset ID (UUID)"
+// @Param        payload   body   handlers.FavouriteNoteRequest   true  "Note payload"
+// @Success      200       {object} handlers.FavouriteResponse
+// @Failure      400       {object} handlers.ErrorResponse
+// @Failure      404       {object} handlers.ErrorResponse
+// @Failure      500       {object} handlers.ErrorResponse
+// @Router       /users/{user_id}/favourites/{asset_id} [patch]
+func (handler *FavouritesHandler) EditNote(writer http.ResponseWriter, req *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	userID, ok := parseUUIDParam(writer, req, "user_id")
+	if !ok {
+		return
+	}
+	assetID, ok := parseUUIDParam(writer, req, "asset_id")
+	if !ok {
+		return
+	}
+
+	var body FavouriteNoteRequest
+
+	dec := json.NewDecoder(req.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&body); err != nil {
+		WriteJsonError(writer, "invalid json", http.StatusBadRequest)
+		return
+	}
+
+	f, err := handler.favService.EditNote(req.Context(), userID, assetID, body.Note)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrFavouriteNotFound):
+			WriteJsonError(writer, "favourite not found", http.StatusNotFound)
+			return
+		case errors.Is(err, domain.ErrNoteTooLong):
+			WriteJsonError(writer, "note is too long", http.StatusBadRequest)
+			return
+		default:
+			WriteJsonError(writer, "internal error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	_ = json.NewEncoder(writer).Encode(newFavouriteResponse(f))
 }
 
 // Remove godoc
@@ -188,3 +493,107 @@ func (handler *FavouritesHandler) Remove(writer http.ResponseWriter, req *http.R
 
 	writer.WriteHeader(http.StatusNoContent)
 }
+
+// maxBatchBytes caps the body of a batch request: MaxBatchSize quoted UUIDs with room for whitespace.
+const maxBatchBytes = 64 << 10
+
+// AddMany godoc
+// @Summary      Add favourites in bulk
+// @Description  Favourites up to 100 assets in a single transaction. Each asset gets its own result
+// @Description  (created, already_exists, asset_not_found, asset_archived) instead of failing the batch.
+// @Tags         favourites
+// @Accept       json
+// @Produce      json
+// @Param        user_id  path   string                          true  "User ID (UUID)"
+// @Param        payload  body   handlers.FavouriteBatchRequest   true  "Asset IDs"
+// @Success      200      {object} handlers.FavouriteBatchResponse
+// @Failure      400      {object} handlers.ErrorResponse
+// @Failure      404      {object} handlers.ErrorResponse
+// @Failure      413      {object} handlers.ErrorResponse
+// @Failure      500      {object} handlers.ErrorResponse
+// @Router       /users/{user_id}/favourites/batch [post]
+func (handler *FavouritesHandler) AddMany(writer http.ResponseWriter, req *http.Request) {
+	handler.runBatch(writer, req, handler.favService.AddMany)
+}
+
+// RemoveMany godoc
+// @Summary      Remove favourites in bulk
+// @Description  Removes up to 100 favourites in a single transaction. Each asset gets its own result
+// @Description  (removed, not_found) instead of failing the batch.
+// @Tags         favourites
+// @Accept       json
+// @Produce      json
+// @Param        user_id  path   string                          true  "User ID (UUID)"
+// @Param        payload  body   handlers.FavouriteBatchRequest   true  "Asset IDs"
+// @Success      200      {object} handlers.FavouriteBatchResponse
+// @Failure      400      {object} handlers.ErrorResponse
+// @Failure      404      {object} handlers.ErrorResponse
+// @Failure      413      {object} handlers.ErrorResponse
+// @Failure      500      {object} handlers.ErrorResponse
+// @Router       /users/{user_id}/favourites/batch/remove [post]
+func (handler *FavouritesHandler) RemoveMany(writer http.ResponseWriter, req *http.Request) {
+	handler.runBatch(writer, req, handler.favService.RemoveMany)
+}
+
+// runBatch decodes a FavouriteBatchRequest, runs op and writes the per-asset results.
+func (handler *FavouritesHandler) runBatch(
+	writer http.ResponseWriter,
+	req *http.Request,
+	op func(ctx context.Context, userID uuid.UUID, assetIDs []uuid.UUID) ([]domain.FavouriteResult, error),
+) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	userID, ok := parseUUIDParam(writer, req, "user_id")
+	if !ok {
+		return
+	}
+
+	var body FavouriteBatchRequest
+
+	dec := json.NewDecoder(http.MaxBytesReader(writer, req.Body, maxBatchBytes))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&body); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			WriteJsonError(writer, "request body is too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		WriteJsonError(writer, "invalid json", http.StatusBadRequest)
+		return
+	}
+	// Checked before parsing, so an oversized batch is not parsed at all.
+	if len(body.AssetIDs) > domain.MaxBatchSize {
+		WriteJsonError(writer, fmt.Sprintf("at most %d asset_ids per batch", domain.MaxBatchSize), http.StatusBadRequest)
+		return
+	}
+
+	assetIDs := make([]uuid.UUID, 0, len(body.AssetIDs))
+	for _, raw := range body.AssetIDs {
+		assetID, err := uuid.Parse(raw)
+		if err != nil {
+			WriteJsonError(writer, "invalid asset_id: "+raw, http.StatusBadRequest)
+			return
+		}
+		assetIDs = append(assetIDs, assetID)
+	}
+
+	results, err := op(req.Context(), userID, assetIDs)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound):
+			WriteJsonError(writer, "user not found", http.StatusNotFound)
+			return
+		case errors.Is(err, domain.ErrEmptyBatch):
+			WriteJsonError(writer, "asset_ids is empty", http.StatusBadRequest)
+			return
+		case errors.Is(err, domain.ErrBatchTooLarge):
+			WriteJsonError(writer, fmt.Sprintf("at most %d asset_ids per batch", domain.MaxBatchSize), http.StatusBadRequest)
+			return
+		default:
+			WriteJsonError(writer, "internal error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	_ = json.NewEncoder(writer).Encode(newFavouriteBatchResponse(results))
+}
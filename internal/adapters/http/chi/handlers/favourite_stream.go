@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+)
+
+const (
+	// streamHeartbeat is how often an idle stream sends a comment so proxies keep it open.
+	streamHeartbeat = 15 * time.Second
+	// streamRetry is the reconnect delay suggested to EventSource clients.
+	streamRetry = 3 * time.Second
+)
+
+// Stream godoc
+// @Summary      Stream favourite events
+// @Description  Server-Sent Events stream of the user's favourite changes (added, removed, note_edited) as they
+// @Description  happen. Each event carries an id; on reconnect EventSource sends it back as Last-Event-ID and the
+// @Description  events missed in between are replayed. When they can no longer be replayed a "resync" event is
+// @Description  sent first: refresh from GET /users/{user_id}/favourites/changes. A comment is sent every 15s
+// @Description  while idle. Removals caused by deleting an asset are not streamed.
+// @Tags         favourites
+// @Produce      text/event-stream
+// @Param        user_id        path    string  true   "User ID (UUID)"
+// @Param        Last-Event-ID  header  string  false  "ID of the last event received"
+// @Success      200            {object}  handlers.FavouriteEventResponse
+// @Failure      400            {object}  handlers.ErrorResponse
+// @Failure      404            {object}  handlers.ErrorResponse
+// @Failure      500            {object}  handlers.ErrorResponse
+// @Failure      503            {object}  handlers.ErrorResponse
+// @Router       /users/{user_id}/favourites/stream [get]
+func (handler *FavouritesHandler) Stream(writer http.ResponseWriter, req *http.Request) {
+	userID, ok := parseUUIDParam(writer, req, "user_id")
+	if !ok {
+		return
+	}
+
+	var lastEventID uint64
+	if val := req.Header.Get("Last-Event-ID"); val != "" {
+		id, err := strconv.ParseUint(val, 10, 64)
+		if err != nil {
+			WriteJsonError(writer, "invalid Last-Event-ID", http.StatusBadRequest)
+			return
+		}
+		lastEventID = id
+	}
+
+	sub, err := handler.favService.Subscribe(req.Context(), userID, lastEventID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound):
+			WriteJsonError(writer, "user not found", http.StatusNotFound)
+			return
+		case errors.Is(err, domain.ErrEventsClosed):
+			WriteJsonError(writer, "server is shutting down", http.StatusServiceUnavailable)
+			return
+		default:
+			WriteJsonError(writer, "internal error", http.StatusInternalServerError)
+			return
+		}
+	}
+	defer sub.Cancel()
+
+	// The stream outlives the server's read and write timeouts: the read deadline is lifted and
+	// the write deadline is pushed forward before every write instead.
+	stream := sseWriter{writer: writer, controller: http.NewResponseController(writer)}
+	_ = stream.controller.SetReadDeadline(time.Time{})
+
+	writer.Header().Set("Content-Type", "text/event-stream")
+	writer.Header().Set("Cache-Control", "no-cache")
+	writer.Header().Set("X-Accel-Buffering", "no") // keep reverse proxies from buffering events
+	writer.WriteHeader(http.StatusOK)
+
+	if err := stream.send("retry: %d\n\n", streamRetry.Milliseconds()); err != nil {
+		return
+	}
+	if sub.Gap {
+		if err := stream.send("event: resync\ndata: {}\n\n"); err != nil {
+			return
+		}
+	}
+	for _, event := range sub.Missed {
+		if err := stream.event(event); err != nil {
+			return
+		}
+	}
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-req.Context().Done():
+			return
+		case event, open := <-sub.Events:
+			if !open {
+				// Dropped for falling behind, or shutting down; the client reconnects with Last-Event-ID.
+				return
+			}
+			if err := stream.event(event); err != nil {
+				return
+			}
+		case <-heartbeat.C:
+			if err := stream.send(": heartbeat\n\n"); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// sseWriter writes server-sent events, flushing each one.
+type sseWriter struct {
+	writer     http.ResponseWriter
+	controller *http.ResponseController
+}
+
+// send writes one formatted chunk with a write deadline that outlasts the next heartbeat.
+func (stream sseWriter) send(format string, args ...any) error {
+	err := stream.controller.SetWriteDeadline(time.Now().Add(2 * streamHeartbeat))
+	if err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	if _, err := fmt.Fprintf(stream.writer, format, args...); err != nil {
+		return err
+	}
+	return stream.controller.Flush()
+}
+
+// event writes a favourite event with its ID so the client can resume after it.
+func (stream sseWriter) event(event domain.FavouriteEvent) error {
+	data, err := json.Marshal(newFavouriteEventResponse(event))
+	if err != nil {
+		return err
+	}
+	return stream.send("id: %d\ndata: %s\n\n", event.ID, data)
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+func TestRunBatchRejectsOversizedBodies(t *testing.T) {
+	ids := func(n int) string {
+		quoted := make([]string, n)
+		for i := range quoted {
+			quoted[i] = fmt.Sprintf("%q", uuid.NewString())
+		}
+		return `{"asset_ids":[` + strings.Join(quoted, ",") + `]}`
+	}
+
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantOp     bool
+	}{
+		{name: "full batch", body: ids(domain.MaxBatchSize), wantStatus: http.StatusOK, wantOp: true},
+		{name: "too many ids", body: ids(domain.MaxBatchSize + 1), wantStatus: http.StatusBadRequest},
+		{name: "too many invalid ids", body: `{"asset_ids":[` + strings.Repeat(`"x",`, domain.MaxBatchSize) + `"x"]}`, wantStatus: http.StatusBadRequest},
+		{name: "body too large", body: `{"asset_ids":["` + strings.Repeat("a", maxBatchBytes) + `"]}`, wantStatus: http.StatusRequestEntityTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			op := func(_ context.Context, _ uuid.UUID, assetIDs []uuid.UUID) ([]domain.FavouriteResult, error) {
+				called = true
+				return nil, nil
+			}
+			router := chi.NewRouter()
+			router.Post("/users/{user_id}/favourites/batch", func(w http.ResponseWriter, r *http.Request) {
+				(&FavouritesHandler{}).runBatch(w, r, op)
+			})
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/users/"+uuid.NewString()+"/favourites/batch", strings.NewReader(tt.body))
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus || called != tt.wantOp {
+				t.Fatalf("runBatch() status = %d, op called = %v, want %d, %v (body %s)", rec.Code, called, tt.wantStatus, tt.wantOp, rec.Body)
+			}
+		})
+	}
+}
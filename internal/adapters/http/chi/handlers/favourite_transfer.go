@@ -0,0 +1,328 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+)
+
+// maxImportBytes caps the size of an import upload.
+const maxImportBytes = 5 << 20
+
+// exportWriteTimeout is how long writing one export row may take. The export as a whole has no
+// deadline: it runs outside the request timeout and pushes the server's write deadline forward per row.
+const exportWriteTimeout = 30 * time.Second
+
+// exportColumns is the CSV header of exports; imports need asset_id and may carry note.
+var exportColumns = []string{"asset_id", "asset_type", "description", "note", "favourited_at"}
+
+// Export godoc
+// @Summary      Export favourites
+// @Description  Streams all of the user's favourites with asset metadata, oldest first, as a JSON array or CSV.
+// @Description  The output can be imported again with POST /users/{user_id}/favourites/import. CSV cells that
+// @Description  spreadsheets would run as formulas are prefixed with a single quote.
+// @Tags         favourites
+// @Produce      json
+// @Produce      text/csv
+// @Param        user_id  path   string  true   "User ID (UUID)"
+// @Param        format   query  string  false  "Output format (default json)" Enums(json, csv)
+// @Success      200      {array}   handlers.FavouriteExportRow
+// @Failure      400      {object}  handlers.ErrorResponse
+// @Failure      404      {object}  handlers.ErrorResponse
+// @Failure      500      {object}  handlers.ErrorResponse
+// @Router       /users/{user_id}/favourites/export [get]
+func (handler *FavouritesHandler) Export(writer http.ResponseWriter, req *http.Request) {
+	userID, ok := parseUUIDParam(writer, req, "user_id")
+	if !ok {
+		return
+	}
+	format, ok := parseTransferFormat(writer, req.URL.Query().Get("format"))
+	if !ok {
+		return
+	}
+
+	// Headers are only sent with the first row, so a missing user still gets a proper 404.
+	controller := http.NewResponseController(writer)
+	var rows rowWriter
+	started := false
+	err := handler.favService.Export(req.Context(), userID, func(item domain.FavouritedAsset) error {
+		err := controller.SetWriteDeadline(time.Now().Add(exportWriteTimeout))
+		if err != nil && !errors.Is(err, http.ErrNotSupported) {
+			return err
+		}
+		if !started {
+			started = true
+			rows = startExport(writer, format, userID.String())
+		}
+		return rows.write(newFavouriteExportRow(item))
+	})
+	if err != nil {
+		if !started {
+			if errors.Is(err, domain.ErrUserNotFound) {
+				WriteJsonError(writer, "user not found", http.StatusNotFound)
+				return
+			}
+			WriteJsonError(writer, "internal error", http.StatusInternalServerError)
+		}
+		// Mid-stream the status is gone; the truncated body is all the client gets.
+		return
+	}
+	if !started {
+		rows = startExport(writer, format, userID.String())
+	}
+	rows.close()
+}
+
+// Import godoc
+// @Summary      Import favourites
+// @Description  Adds favourites from a JSON array or CSV file in the export format (up to 1000 rows). Only asset_id
+// @Description  and note are used. Every row is validated like a single add and gets its own result:
+// @Description  created, already_exists, asset_not_found, asset_archived, invalid or failed (with a reason).
+// @Tags         favourites
+// @Accept       json
+// @Accept       text/csv
+// @Produce      json
+// @Param        user_id  path   string                         true   "User ID (UUID)"
+// @Param        format   query  string                         false  "Input format; defaults from Content-Type, else json" Enums(json, csv)
+// @Param        payload  body   []handlers.FavouriteExportRow  true   "Rows to import"
+// @Success      200      {object}  handlers.FavouriteImportResponse
+// @Failure      400      {object}  handlers.ErrorResponse
+// @Failure      404      {object}  handlers.ErrorResponse
+// @Failure      413      {object}  handlers.ErrorResponse
+// @Failure      500      {object}  handlers.ErrorResponse
+// @Router       /users/{user_id}/favourites/import [post]
+func (handler *FavouritesHandler) Import(writer http.ResponseWriter, req *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	userID, ok := parseUUIDParam(writer, req, "user_id")
+	if !ok {
+		return
+	}
+
+	rawFormat := req.URL.Query().Get("format")
+	if rawFormat == "" {
+		if mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type")); mediaType == "text/csv" {
+			rawFormat = "csv"
+		}
+	}
+	format, ok := parseTransferFormat(writer, rawFormat)
+	if !ok {
+		return
+	}
+
+	body := http.MaxBytesReader(writer, req.Body, maxImportBytes)
+	var rows []domain.ImportRow
+	var err error
+	if format == "csv" {
+		rows, err = readCSVImport(body)
+	} else {
+		rows, err = readJSONImport(body)
+	}
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			WriteJsonError(writer, "import file is too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		WriteJsonError(writer, "invalid "+format+": "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	results, err := handler.favService.Import(req.Context(), userID, rows)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound):
+			WriteJsonError(writer, "user not found", http.StatusNotFound)
+			return
+		case errors.Is(err, domain.ErrTooManyImportRows):
+			WriteJsonError(writer, fmt.Sprintf("at most %d rows per import", domain.MaxImportRows), http.StatusBadRequest)
+			return
+		default:
+			WriteJsonError(writer, "internal error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	_ = json.NewEncoder(writer).Encode(newFavouriteImportResponse(results))
+}
+
+// parseTransferFormat accepts "json" (the default) or "csv".
+// On other values, it writes a 400 Bad Request response and returns ok=false.
+func parseTransferFormat(writer http.ResponseWriter, val string) (string, bool) {
+	switch val {
+	case "", "json":
+		return "json", true
+	case "csv":
+		return "csv", true
+	default:
+		WriteJsonError(writer, "invalid format", http.StatusBadRequest)
+		return "", false
+	}
+}
+
+// rowWriter streams export rows in one format.
+type rowWriter interface {
+	write(row FavouriteExportRow) error
+	close()
+}
+
+// startExport sends the export headers and returns the writer for the chosen format.
+func startExport(writer http.ResponseWriter, format, userID string) rowWriter {
+	filename := fmt.Sprintf("favourites-%s.%s", userID, format)
+	writer.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	if format == "csv" {
+		writer.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w := csv.NewWriter(writer)
+		_ = w.Write(exportColumns)
+		return &csvRowWriter{w: w}
+	}
+	writer.Header().Set("Content-Type", "application/json")
+	_, _ = io.WriteString(writer, "[")
+	return &jsonRowWriter{w: writer}
+}
+
+type jsonRowWriter struct {
+	w    io.Writer
+	rows int
+}
+
+func (rows *jsonRowWriter) write(row FavouriteExportRow) error {
+	b, err := json.Marshal(row)
+	if err != nil {
+		return err
+	}
+	if rows.rows > 0 {
+		if _, err := io.WriteString(rows.w, ","); err != nil {
+			return err
+		}
+	}
+	rows.rows++
+	_, err = rows.w.Write(b)
+	return err
+}
+
+func (rows *jsonRowWriter) close() {
+	_, _ = io.WriteString(rows.w, "]\n")
+}
+
+type csvRowWriter struct {
+	w *csv.Writer
+}
+
+// The free-text columns are escaped, so a spreadsheet opening the file shows them instead of
+// evaluating them as formulas.
+func (rows *csvRowWriter) write(row FavouriteExportRow) error {
+	return rows.w.Write([]string{
+		row.AssetID,
+		row.AssetType,
+		escapeCSVFormula(row.Description),
+		escapeCSVFormula(row.Note),
+		row.FavouritedAt,
+	})
+}
+
+func (rows *csvRowWriter) close() {
+	rows.w.Flush()
+}
+
+// readJSONImport reads a JSON array of export rows.
+func readJSONImport(r io.Reader) ([]domain.ImportRow, error) {
+	var in []FavouriteExportRow
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&in); err != nil {
+		return nil, err
+	}
+
+	rows := make([]domain.ImportRow, 0, len(in))
+	for _, row := range in {
+		rows = append(rows, domain.ImportRow{AssetID: row.AssetID, Note: row.Note})
+	}
+	return rows, nil
+}
+
+// readCSVImport reads a CSV file whose header names at least the asset_id column.
+func readCSVImport(r io.Reader) ([]domain.ImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1 // short rows just have no note
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("missing header: %w", err)
+	}
+	assetCol := slices.Index(header, "asset_id")
+	if assetCol < 0 {
+		return nil, errors.New("header has no asset_id column")
+	}
+	noteCol := slices.Index(header, "note")
+
+	var rows []domain.ImportRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		// Stop reading once the service would reject the file anyway.
+		if len(rows) > domain.MaxImportRows {
+			return rows, nil
+		}
+
+		var row domain.ImportRow
+		if assetCol < len(record) {
+			row.AssetID = record[assetCol]
+		}
+		if noteCol >= 0 && noteCol < len(record) {
+			row.Note = unescapeCSVFormula(record[noteCol])
+		}
+		rows = append(rows, row)
+	}
+}
+
+// csvFormulaPrefixes are the leading characters that make spreadsheets read a cell as a formula.
+const csvFormulaPrefixes = "=+-@\t\r"
+
+// escapeCSVFormula prefixes a cell that a spreadsheet would evaluate with a single quote. Cells that
+// already look escaped get one more, so unescapeCSVFormula restores every cell exactly.
+func escapeCSVFormula(cell string) string {
+	if isCSVFormula(cell) {
+		return "'" + cell
+	}
+	return cell
+}
+
+// unescapeCSVFormula reverts escapeCSVFormula, so exported notes import unchanged.
+func unescapeCSVFormula(cell string) string {
+	if rest, ok := strings.CutPrefix(cell, "'"); ok && isCSVFormula(rest) {
+		return rest
+	}
+	return cell
+}
+
+// isCSVFormula reports whether cell, after any leading single quotes, starts like a formula.
+func isCSVFormula(cell string) bool {
+	cell = strings.TrimLeft(cell, "'")
+	return cell != "" && strings.ContainsRune(csvFormulaPrefixes, rune(cell[0]))
+}
+
+// newFavouriteExportRow maps a favourited asset to an export row.
+func newFavouriteExportRow(item domain.FavouritedAsset) FavouriteExportRow {
+	return FavouriteExportRow{
+		AssetID:      item.Asset.ID.String(),
+		AssetType:    string(item.Asset.Type),
+		Description:  item.Asset.Description,
+		Note:         item.Favourite.Note,
+		FavouritedAt: item.Favourite.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
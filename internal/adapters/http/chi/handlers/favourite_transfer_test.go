@@ -0,0 +1,34 @@
+package handlers
+
+import "testing"
+
+func TestEscapeCSVFormula(t *testing.T) {
+	tests := []struct {
+		cell string
+		want string
+	}{
+		{cell: "", want: ""},
+		{cell: "plain note", want: "plain note"},
+		{cell: "=HYPERLINK(\"http://x\")", want: "'=HYPERLINK(\"http://x\")"},
+		{cell: "+1", want: "'+1"},
+		{cell: "-1", want: "'-1"},
+		{cell: "@SUM(A1)", want: "'@SUM(A1)"},
+		{cell: "\tcmd", want: "'\tcmd"},
+		{cell: "\rcmd", want: "'\rcmd"},
+		{cell: "'quoted", want: "'quoted"},
+		{cell: "'=already escaped", want: "''=already escaped"},
+		{cell: "a=b", want: "a=b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.cell, func(t *testing.T) {
+			got := escapeCSVFormula(tt.cell)
+			if got != tt.want {
+				t.Fatalf("escapeCSVFormula(%q) = %q, want %q", tt.cell, got, tt.want)
+			}
+			if back := unescapeCSVFormula(got); back != tt.cell {
+				t.Fatalf("unescapeCSVFormula(%q) = %q, want %q", got, back, tt.cell)
+			}
+		})
+	}
+}
@@ -4,7 +4,10 @@ import (
 	"encoding/json"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 )
@@ -21,6 +24,65 @@ func parseUUIDParam(writer http.ResponseWriter, req *http.Request, key string) (
 	return id, true
 }
 
+// ActorHeader carries the ID of the user performing a request, when known.
+const ActorHeader = "X-User-ID"
+
+// parseActorHeader reads the optional ActorHeader as a UUID.
+// A missing header yields (nil, true). On a malformed value, it writes a
+// 400 Bad Request response and returns (nil, false).
+func parseActorHeader(writer http.ResponseWriter, req *http.Request) (*uuid.UUID, bool) {
+	val := req.Header.Get(ActorHeader)
+	if val == "" {
+		return nil, true
+	}
+	id, err := uuid.Parse(val)
+	if err != nil {
+		WriteJsonError(writer, "invalid "+ActorHeader+" header", http.StatusBadRequest)
+		return nil, false
+	}
+	return &id, true
+}
+
+// assetETag renders an asset version as a strong ETag, e.g. "3".
+func assetETag(a *domain.Asset) string {
+	return strconv.Quote(strconv.Itoa(a.Version))
+}
+
+// parseIfMatch reads the optional If-Match header: "*" or a comma-separated list of asset ETags.
+// A missing header or "*" yields (nil, true); otherwise the versions any of which the asset must
+// still be at. If-Match compares strongly, so weak tags (W/"3") never match: when no strong tag
+// is left it writes 412 Precondition Failed, and on a malformed value 400 Bad Request, returning (nil, false).
+func parseIfMatch(writer http.ResponseWriter, req *http.Request) ([]int, bool) {
+	val := strings.TrimSpace(req.Header.Get("If-Match"))
+	if val == "" || val == "*" {
+		return nil, true
+	}
+
+	var versions []int
+	for tag := range strings.SplitSeq(val, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || strings.HasPrefix(tag, "W/") {
+			continue
+		}
+		unquoted, err := strconv.Unquote(tag)
+		if err != nil {
+			// Bare versions (3 instead of "3") are tolerated.
+			unquoted = tag
+		}
+		version, err := strconv.Atoi(unquoted)
+		if err != nil || version <= 0 {
+			WriteJsonError(writer, "invalid If-Match header", http.StatusBadRequest)
+			return nil, false
+		}
+		versions = append(versions, version)
+	}
+	if len(versions) == 0 {
+		WriteJsonError(writer, "If-Match needs a strong ETag", http.StatusPreconditionFailed)
+		return nil, false
+	}
+	return versions, true
+}
+
 // parsePagination reads "limit" and "offset" query parameters, validates them,
 // applies defaults (limit=20, max 50), and returns them.
 // On invalid values, it writes a 400 Bad Request response and returns ok=false.
@@ -54,6 +116,37 @@ func parsePagination(writer http.ResponseWriter, req *http.Request) (limit, offs
 	return limit, offset, true
 }
 
+// parseTimeQuery reads an optional RFC3339 timestamp query parameter.
+// On error, it writes a 400 Bad Request response and returns ok=false.
+func parseTimeQuery(writer http.ResponseWriter, req *http.Request, key string) (*time.Time, bool) {
+	val := req.URL.Query().Get(key)
+	if val == "" {
+		return nil, true
+	}
+	t, err := time.Parse(time.RFC3339, val)
+	if err != nil {
+		WriteJsonError(writer, "invalid "+key, http.StatusBadRequest)
+		return nil, false
+	}
+	t = t.UTC()
+	return &t, true
+}
+
+// parseUUIDQuery reads an optional UUID query parameter.
+// On error, it writes a 400 Bad Request response and returns ok=false.
+func parseUUIDQuery(writer http.ResponseWriter, req *http.Request, key string) (*uuid.UUID, bool) {
+	val := req.URL.Query().Get(key)
+	if val == "" {
+		return nil, true
+	}
+	id, err := uuid.Parse(val)
+	if err != nil {
+		WriteJsonError(writer, "invalid "+key, http.StatusBadRequest)
+		return nil, false
+	}
+	return &id, true
+}
+
 func WriteJsonError(writer http.ResponseWriter, msg string, status int) {
 	writer.Header().Set("Content-Type", "application/json")
 	writer.WriteHeader(status)
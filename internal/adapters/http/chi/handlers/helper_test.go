@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestParseIfMatch(t *testing.T) {
+	tests := []struct {
+		name       string
+		header     string
+		want       []int
+		wantOK     bool
+		wantStatus int
+	}{
+		{name: "absent", wantOK: true},
+		{name: "any", header: "*", wantOK: true},
+		{name: "strong tag", header: `"3"`, want: []int{3}, wantOK: true},
+		{name: "list", header: `"3", "4"`, want: []int{3, 4}, wantOK: true},
+		{name: "bare version", header: `3`, want: []int{3}, wantOK: true},
+		{name: "weak tags are skipped", header: `W/"2", "3"`, want: []int{3}, wantOK: true},
+		{name: "only weak tags", header: `W/"3"`, wantStatus: http.StatusPreconditionFailed},
+		{name: "not a version", header: `"abc"`, wantStatus: http.StatusBadRequest},
+		{name: "zero", header: `"0"`, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPatch, "/assets/x/description", nil)
+			if tt.header != "" {
+				req.Header.Set("If-Match", tt.header)
+			}
+
+			got, ok := parseIfMatch(rec, req)
+			if ok != tt.wantOK || !slices.Equal(got, tt.want) {
+				t.Fatalf("parseIfMatch(%q) = %v, %v, want %v, %v", tt.header, got, ok, tt.want, tt.wantOK)
+			}
+			if !ok && rec.Code != tt.wantStatus {
+				t.Fatalf("parseIfMatch(%q) status = %d, want %d", tt.header, rec.Code, tt.wantStatus)
+			}
+		})
+	}
+}
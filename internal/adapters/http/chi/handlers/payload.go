@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+)
+
+// AssetPayload is the JSON shape of an asset payload. Only the fields
+// belonging to the asset type are set:
+//   - chart: title, x/y or series/values, y_label
+//   - insight: text
+//   - audience: gender, age_group, country, social_hours, purchases_month
+type AssetPayload struct {
+	Title  string    `json:"title,omitempty" example:"DAU"`
+	X      []string  `json:"x,omitempty" example:"Mon,Tue,Wed"`
+	Y      []float64 `json:"y,omitempty" example:"120,150,160"`
+	Series []string  `json:"series,omitempty" example:"Email,Ads,Organic"`
+	Values []float64 `json:"values,omitempty" example:"2.1,1.3,3.2"`
+	YLabel string    `json:"y_label,omitempty" example:"%"`
+
+	Text string `json:"text,omitempty" example:"40% of millennials spend more than 3 hours on social media daily"`
+
+	Gender         string `json:"gender,omitempty" example:"Male"`
+	AgeGroup       string `json:"age_group,omitempty" example:"24-35"`
+	Country        string `json:"country,omitempty" example:"US"`
+	SocialHours    string `json:"social_hours,omitempty" example:">3"`
+	PurchasesMonth string `json:"purchases_month,omitempty" example:">1"`
+}
+
+// newAssetPayload maps a typed domain payload to its JSON shape.
+func newAssetPayload(payload domain.AssetPayload) AssetPayload {
+	switch p := payload.(type) {
+	case domain.ChartPayload:
+		return AssetPayload{Title: p.Title, X: p.X, Y: p.Y, Series: p.Series, Values: p.Values, YLabel: p.YLabel}
+	case domain.InsightPayload:
+		return AssetPayload{Text: p.Text}
+	case domain.AudiencePayload:
+		return AssetPayload{
+			Gender:         p.Gender,
+			AgeGroup:       p.AgeGroup,
+			Country:        p.Country,
+			SocialHours:    p.SocialHours,
+			PurchasesMonth: p.PurchasesMonth,
+		}
+	default:
+		return AssetPayload{}
+	}
+}
+
+// toDomain maps the JSON shape to the typed payload of assetType.
+// Fields that belong to another asset type are rejected.
+func (p AssetPayload) toDomain(assetType domain.AssetType) (domain.AssetPayload, error) {
+	chartSet := p.Title != "" || len(p.X) > 0 || len(p.Y) > 0 || len(p.Series) > 0 || len(p.Values) > 0 || p.YLabel != ""
+	insightSet := p.Text != ""
+	audienceSet := p.Gender != "" || p.AgeGroup != "" || p.Country != "" || p.SocialHours != "" || p.PurchasesMonth != ""
+
+	switch assetType {
+	case domain.AssetTypeChart:
+		if insightSet || audienceSet {
+			return nil, fmt.Errorf("%w: chart payload has fields of another asset type", domain.ErrInvalidPayload)
+		}
+		return domain.ChartPayload{Title: p.Title, X: p.X, Y: p.Y, Series: p.Series, Values: p.Values, YLabel: p.YLabel}, nil
+	case domain.AssetTypeInsight:
+		if chartSet || audienceSet {
+			return nil, fmt.Errorf("%w: insight payload has fields of another asset type", domain.ErrInvalidPayload)
+		}
+		return domain.InsightPayload{Text: p.Text}, nil
+	case domain.AssetTypeAudience:
+		if chartSet || insightSet {
+			return nil, fmt.Errorf("%w: audience payload has fields of another asset type", domain.ErrInvalidPayload)
+		}
+		return domain.AudiencePayload{
+			Gender:         p.Gender,
+			AgeGroup:       p.AgeGroup,
+			Country:        p.Country,
+			SocialHours:    p.SocialHours,
+			PurchasesMonth: p.PurchasesMonth,
+		}, nil
+	default:
+		return nil, domain.ErrInvalidAssetType
+	}
+}
@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/app"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+)
+
+// PopularityHandler serves the popular and trending asset rankings.
+type PopularityHandler struct {
+	favService *app.FavouritesService
+}
+
+func NewPopularityHandler(favService *app.FavouritesService) *PopularityHandler {
+	return &PopularityHandler{favService: favService}
+}
+
+// Popular godoc
+// @Summary      Most favourited assets
+// @Description  Ranks non-archived assets by all-time favourite count (ties by asset id).
+// @Tags         assets
+// @Accept       json
+// @Produce      json
+// @Param        limit   query  int  false  "Max items to return (default 20, max 50)"
+// @Param        offset  query  int  false  "Items to skip (use next_offset)"
+// @Success      200     {object}  handlers.PopularAssetsResponse
+// @Failure      400     {object}  handlers.ErrorResponse
+// @Failure      500     {object}  handlers.ErrorResponse
+// @Router       /assets/popular [get]
+func (handler *PopularityHandler) Popular(writer http.ResponseWriter, req *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	limit, offset, ok := parsePagination(writer, req)
+	if !ok {
+		return
+	}
+
+	items, more, err := handler.favService.Popular(req.Context(), limit, offset)
+	if err != nil {
+		WriteJsonError(writer, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	_ = json.NewEncoder(writer).Encode(newPopularAssetsResponse(items, more, limit, offset))
+}
+
+// Trending godoc
+// @Summary      Trending assets
+// @Description  Ranks non-archived assets by the favourites they received within a recent window (ties by asset id).
+// @Tags         assets
+// @Accept       json
+// @Produce      json
+// @Param        window  query  string  false  "Window ending now, in days (7d) or a Go duration (36h); default 7d, max 90d"
+// @Param        limit   query  int     false  "Max items to return (default 20, max 50)"
+// @Param        offset  query  int     false  "Items to skip (use next_offset)"
+// @Success      200     {object}  handlers.PopularAssetsResponse
+// @Failure      400     {object}  handlers.ErrorResponse
+// @Failure      500     {object}  handlers.ErrorResponse
+// @Router       /assets/trending [get]
+func (handler *PopularityHandler) Trending(writer http.ResponseWriter, req *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	limit, offset, ok := parsePagination(writer, req)
+	if !ok {
+		return
+	}
+
+	window := domain.DefaultTrendingWindow
+	if val := req.URL.Query().Get("window"); val != "" {
+		var err error
+		if window, err = parseWindow(val); err != nil {
+			WriteJsonError(writer, "invalid window", http.StatusBadRequest)
+			return
+		}
+	}
+
+	items, more, err := handler.favService.Trending(req.Context(), window, limit, offset)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidWindow) {
+			WriteJsonError(writer, "window must be positive and at most 90d", http.StatusBadRequest)
+			return
+		}
+
+		WriteJsonError(writer, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	_ = json.NewEncoder(writer).Encode(newPopularAssetsResponse(items, more, limit, offset))
+}
+
+// maxWindowDays bounds "Nd" windows before they are converted, so the duration can't overflow.
+const maxWindowDays = 3650
+
+// parseWindow accepts a number of days ("7d", 1 to maxWindowDays) or anything time.ParseDuration does ("36h").
+func parseWindow(val string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(val, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, err
+		}
+		if n <= 0 || n > maxWindowDays {
+			return 0, fmt.Errorf("window of %d days out of range", n)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(val)
+}
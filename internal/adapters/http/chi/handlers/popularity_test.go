@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseWindow(t *testing.T) {
+	tests := []struct {
+		val     string
+		want    time.Duration
+		wantErr bool
+	}{
+		{val: "7d", want: 7 * 24 * time.Hour},
+		{val: "36h", want: 36 * time.Hour},
+		{val: "3650d", want: 3650 * 24 * time.Hour},
+		{val: "3651d", wantErr: true},
+		{val: "0d", wantErr: true},
+		{val: "-1d", wantErr: true},
+		{val: "106752d", wantErr: true}, // would overflow time.Duration
+		{val: "9223372036854775807d", wantErr: true},
+		{val: "xd", wantErr: true},
+		{val: "week", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.val, func(t *testing.T) {
+			got, err := parseWindow(tt.val)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseWindow(%q) error = %v, wantErr %v", tt.val, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("parseWindow(%q) = %v, want %v", tt.val, got, tt.want)
+			}
+		})
+	}
+}
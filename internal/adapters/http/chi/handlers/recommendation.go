@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/app"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+)
+
+// RecommendationHandler serves co-occurrence based asset recommendations.
+type RecommendationHandler struct {
+	recService *app.RecommendationService
+}
+
+func NewRecommendationHandler(recService *app.RecommendationService) *RecommendationHandler {
+	return &RecommendationHandler{recService: recService}
+}
+
+// Related godoc
+// @Summary      Related assets
+// @Description  Users who favourited this asset also favourited these, most shared first.
+// @Description  Computed from an in-memory model refreshed in the background, so very recent favourites may be missing.
+// @Tags         assets
+// @Accept       json
+// @Produce      json
+// @Param        asset_id  path   string  true   "Asset ID (UUID)"
+// @Param        limit     query  int     false  "Max items to return (default 20, max 50)"
+// @Success      200       {object}  handlers.RecommendationsResponse
+// @Failure      400       {object}  handlers.ErrorResponse
+// @Failure      404       {object}  handlers.ErrorResponse
+// @Failure      500       {object}  handlers.ErrorResponse
+// @Router       /assets/{asset_id}/related [get]
+func (handler *RecommendationHandler) Related(writer http.ResponseWriter, req *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	assetID, ok := parseUUIDParam(writer, req, "asset_id")
+	if !ok {
+		return
+	}
+	limit, _, ok := parsePagination(writer, req)
+	if !ok {
+		return
+	}
+
+	items, err := handler.recService.Related(req.Context(), assetID, limit)
+	if err != nil {
+		if errors.Is(err, domain.ErrAssetNotFound) {
+			WriteJsonError(writer, "asset not found", http.StatusNotFound)
+			return
+		}
+
+		WriteJsonError(writer, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	_ = json.NewEncoder(writer).Encode(newRecommendationsResponse(items))
+}
+
+// ForUser godoc
+// @Summary      Recommendations for a user
+// @Description  Assets co-favourited with the user's favourites, excluding assets the user already favourited.
+// @Tags         users
+// @Accept       json
+// @Produce      json
+// @Param        user_id  path   string  true   "User ID (UUID)"
+// @Param        limit    query  int     false  "Max items to return (default 20, max 50)"
+// @Success      200      {object}  handlers.RecommendationsResponse
+// @Failure      400      {object}  handlers.ErrorResponse
+// @Failure      404      {object}  handlers.ErrorResponse
+// @Failure      500      {object}  handlers.ErrorResponse
+// @Router       /users/{user_id}/recommendations [get]
+func (handler *RecommendationHandler) ForUser(writer http.ResponseWriter, req *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	userID, ok := parseUUIDParam(writer, req, "user_id")
+	if !ok {
+		return
+	}
+	limit, _, ok := parsePagination(writer, req)
+	if !ok {
+		return
+	}
+
+	items, err := handler.recService.Recommendations(req.Context(), userID, limit)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			WriteJsonError(writer, "user not found", http.StatusNotFound)
+			return
+		}
+
+		WriteJsonError(writer, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	_ = json.NewEncoder(writer).Encode(newRecommendationsResponse(items))
+}
@@ -1,6 +1,9 @@
 package handlers
 
 import (
+	"encoding/json"
+	"time"
+
 	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
 	"github.com/google/uuid"
 )
@@ -20,6 +23,13 @@ type UserResponse struct {
 
 // --- Assets ---
 
+// AssetCreateRequest is the body for POST /api/assets.
+type AssetCreateRequest struct {
+	Type        domain.AssetType `json:"type" example:"insight"`
+	Description string           `json:"description" example:"Insight: weekend traffic peak"`
+	Payload     AssetPayload     `json:"payload"`
+}
+
 // AssetEditRequest is the body for PATCH /api/assets/{asset_id}/description.
 type AssetEditRequest struct {
 	Description string `json:"description" example:"New description from Swagger"`
@@ -30,7 +40,102 @@ type AssetResponse struct {
 	ID          uuid.UUID        `json:"id" example:"aaaaaaa1-0000-0000-0000-000000000001"`
 	Type        domain.AssetType `json:"type" example:"chart"`
 	Description string           `json:"description" example:"Daily active users - last 7 days"`
-	Payload     map[string]any   `json:"payload" swaggertype:"object"`
+	Payload     AssetPayload     `json:"payload"`
+	Version     int              `json:"version" example:"1"`
+	CreatedAt   string           `json:"created_at" example:"2025-09-08T12:34:56Z"`
+	ArchivedAt  *string          `json:"archived_at,omitempty" example:"2025-09-10T08:00:00Z"`
+}
+
+// newAssetResponse maps a domain asset to its response shape.
+func newAssetResponse(a domain.Asset) AssetResponse {
+	resp := AssetResponse{
+		ID:          a.ID,
+		Type:        a.Type,
+		Description: a.Description,
+		Payload:     newAssetPayload(a.Payload),
+		Version:     a.Version,
+		CreatedAt:   a.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	if a.ArchivedAt != nil {
+		archivedAt := a.ArchivedAt.UTC().Format(time.RFC3339)
+		resp.ArchivedAt = &archivedAt
+	}
+	return resp
+}
+
+// AssetCatalogueResponse wraps a page of the asset catalogue plus the next page cursor.
+type AssetCatalogueResponse struct {
+	Items     []AssetResponse `json:"items"`
+	NextAfter *string         `json:"next_after,omitempty"`
+}
+
+// AssetRevisionResponse is one entry of an asset's description edit history.
+type AssetRevisionResponse struct {
+	ID             uuid.UUID  `json:"id" example:"5f0c9f3e-2d4b-4c1e-9a57-0d1b2c3d4e5f"`
+	AssetID        uuid.UUID  `json:"asset_id" example:"aaaaaaa1-0000-0000-0000-000000000001"`
+	OldDescription string     `json:"old_description" example:"Daily active users - last 7 days"`
+	NewDescription string     `json:"new_description" example:"New description from Swagger"`
+	EditorID       *uuid.UUID `json:"editor_id,omitempty" example:"11111111-1111-1111-1111-111111111111"`
+	CreatedAt      string     `json:"created_at" example:"2025-09-08T12:34:56Z"`
+}
+
+// AssetRevisionsListResponse wraps a page of revisions plus the next page cursor.
+type AssetRevisionsListResponse struct {
+	Items     []AssetRevisionResponse `json:"items"`
+	NextAfter *string                 `json:"next_after,omitempty"`
+}
+
+// PopularAssetResponse is an asset together with its favourite count.
+type PopularAssetResponse struct {
+	AssetResponse
+	FavouriteCount int `json:"favourite_count" example:"12"`
+}
+
+// PopularAssetsResponse is a page of ranked assets; next_offset is set when more follow.
+type PopularAssetsResponse struct {
+	Items      []PopularAssetResponse `json:"items"`
+	NextOffset *int                   `json:"next_offset,omitempty" example:"20"`
+}
+
+// newPopularAssetsResponse maps a page of ranked assets to its response shape.
+func newPopularAssetsResponse(items []domain.PopularAsset, more bool, limit, offset int) PopularAssetsResponse {
+	out := make([]PopularAssetResponse, 0, len(items))
+	for _, item := range items {
+		out = append(out, PopularAssetResponse{
+			AssetResponse:  newAssetResponse(item.Asset),
+			FavouriteCount: item.Favourites,
+		})
+	}
+
+	resp := PopularAssetsResponse{Items: out}
+	if more {
+		next := offset + limit
+		resp.NextOffset = &next
+	}
+	return resp
+}
+
+// RecommendedAssetResponse is a recommended asset with its co-occurrence score.
+type RecommendedAssetResponse struct {
+	AssetResponse
+	Score int `json:"score" example:"3"`
+}
+
+// RecommendationsResponse lists recommended assets, best first.
+type RecommendationsResponse struct {
+	Items []RecommendedAssetResponse `json:"items"`
+}
+
+// newRecommendationsResponse maps recommended assets to their response shape.
+func newRecommendationsResponse(items []domain.RecommendedAsset) RecommendationsResponse {
+	out := make([]RecommendedAssetResponse, 0, len(items))
+	for _, item := range items {
+		out = append(out, RecommendedAssetResponse{
+			AssetResponse: newAssetResponse(item.Asset),
+			Score:         item.Score,
+		})
+	}
+	return RecommendationsResponse{Items: out}
 }
 
 // --- Favourites ---
@@ -38,22 +143,312 @@ type AssetResponse struct {
 // FavouriteAddRequest is the body for POST /api/users/{user_id}/favourites.
 type FavouriteAddRequest struct {
 	AssetID string `json:"asset_id" example:"aaaaaaa1-0000-0000-0000-000000000001"`
+	Note    string `json:"note,omitempty" example:"Use in Q3 deck"`
 }
 
-// FavouriteResponse is returned when creating favourites.
+// FavouriteNoteRequest is the body for PATCH /api/users/{user_id}/favourites/{asset_id}.
+type FavouriteNoteRequest struct {
+	Note string `json:"note" example:"Use in Q3 deck"`
+}
+
+// FavouriteResponse is returned when creating or editing favourites.
 type FavouriteResponse struct {
 	UserID    uuid.UUID `json:"user_id"  example:"11111111-1111-1111-1111-111111111111"`
 	AssetID   uuid.UUID `json:"asset_id" example:"aaaaaaa1-0000-0000-0000-000000000001"`
+	Note      string    `json:"note" example:"Use in Q3 deck"`
 	CreatedAt string    `json:"created_at" example:"2025-09-08T12:34:56Z"`
 }
 
-// AssetsListResponse wraps a list of assets plus the next page cursor.
+// newFavouriteResponse maps a domain favourite to its response shape.
+func newFavouriteResponse(f domain.Favourite) FavouriteResponse {
+	return FavouriteResponse{
+		UserID:    f.UserID,
+		AssetID:   f.AssetID,
+		Note:      f.Note,
+		CreatedAt: f.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// FavouriteItemResponse is a favourited asset plus the user's favourite metadata.
+type FavouriteItemResponse struct {
+	AssetResponse
+	FavouriteID  uuid.UUID `json:"favourite_id" example:"f0000000-0000-0000-0000-000000000001"`
+	FavouritedAt string    `json:"favourited_at" example:"2025-09-08T12:34:56Z"`
+	Note         string    `json:"note" example:"Use in Q3 deck"`
+}
+
+// newFavouriteItemResponse maps a favourited asset to its list item shape.
+func newFavouriteItemResponse(item domain.FavouritedAsset) FavouriteItemResponse {
+	return FavouriteItemResponse{
+		AssetResponse: newAssetResponse(item.Asset),
+		FavouriteID:   item.Favourite.ID,
+		FavouritedAt:  item.Favourite.CreatedAt.UTC().Format(time.RFC3339),
+		Note:          item.Favourite.Note,
+	}
+}
+
+// AssetsListResponse wraps a list of favourited assets plus the next and previous page cursors.
+// Total is only present when requested with include=total.
 type AssetsListResponse struct {
-	Items     []AssetResponse `json:"items"`
-	NextAfter *string         `json:"next_after,omitempty"`
+	Items      []FavouriteItemResponse `json:"items"`
+	NextAfter  *string                 `json:"next_after,omitempty"`
+	PrevBefore *string                 `json:"prev_before,omitempty"`
+	Total      *int                    `json:"total,omitempty" example:"42"`
+}
+
+// FavouriteStatus tells whether one asset is favourited and since when.
+type FavouriteStatus struct {
+	Favourited   bool    `json:"favourited" example:"true"`
+	FavouritedAt *string `json:"favourited_at,omitempty" example:"2025-09-08T12:34:56Z"`
+}
+
+// FavouriteStatusResponse maps each requested asset ID to its favourite status.
+type FavouriteStatusResponse struct {
+	Items map[string]FavouriteStatus `json:"items"`
+}
+
+// newFavouriteStatusResponse maps the per-asset favourites (nil when not favourited) to their response shape.
+func newFavouriteStatusResponse(status map[uuid.UUID]*domain.Favourite) FavouriteStatusResponse {
+	out := make(map[string]FavouriteStatus, len(status))
+	for assetID, f := range status {
+		var st FavouriteStatus
+		if f != nil {
+			at := f.CreatedAt.UTC().Format(time.RFC3339)
+			st = FavouriteStatus{Favourited: true, FavouritedAt: &at}
+		}
+		out[assetID.String()] = st
+	}
+	return FavouriteStatusResponse{Items: out}
+}
+
+// FavouriteExportRow is one favourite in an export, and the row format of imports.
+type FavouriteExportRow struct {
+	AssetID      string `json:"asset_id" example:"aaaaaaa1-0000-0000-0000-000000000001"`
+	AssetType    string `json:"asset_type,omitempty" example:"chart"`
+	Description  string `json:"description,omitempty" example:"Daily active users"`
+	Note         string `json:"note,omitempty" example:"Use in Q3 deck"`
+	FavouritedAt string `json:"favourited_at,omitempty" example:"2025-09-08T12:34:56Z"`
+}
+
+// FavouriteImportRowResponse is the outcome of one import row (1-based).
+type FavouriteImportRowResponse struct {
+	Row     int    `json:"row" example:"1"`
+	AssetID string `json:"asset_id" example:"aaaaaaa1-0000-0000-0000-000000000001"`
+	Result  string `json:"result" example:"created" enums:"created,already_exists,asset_not_found,asset_archived,invalid,failed"`
+	Reason  string `json:"reason,omitempty" example:"invalid asset_id"`
+}
+
+// FavouriteImportResponse lists the per-row outcomes of an import and how many favourites were created.
+type FavouriteImportResponse struct {
+	Created int                          `json:"created" example:"3"`
+	Results []FavouriteImportRowResponse `json:"results"`
+}
+
+// newFavouriteImportResponse maps import results to their response shape.
+func newFavouriteImportResponse(results []domain.ImportResult) FavouriteImportResponse {
+	resp := FavouriteImportResponse{Results: make([]FavouriteImportRowResponse, 0, len(results))}
+	for _, r := range results {
+		if r.Outcome == domain.FavouriteCreated {
+			resp.Created++
+		}
+		resp.Results = append(resp.Results, FavouriteImportRowResponse{
+			Row:     r.Row,
+			AssetID: r.AssetID,
+			Result:  string(r.Outcome),
+			Reason:  r.Reason,
+		})
+	}
+	return resp
+}
+
+// FavouriteChangeResponse is one entry of the favourites change feed. Favourite is set for additions.
+type FavouriteChangeResponse struct {
+	Type      string                 `json:"type" example:"added" enums:"added,removed"`
+	AssetID   uuid.UUID              `json:"asset_id" example:"aaaaaaa1-0000-0000-0000-000000000001"`
+	At        string                 `json:"at" example:"2025-09-08T12:34:56Z"`
+	Favourite *FavouriteItemResponse `json:"favourite,omitempty"`
+}
+
+// FavouriteChangesResponse is a page of the change feed plus the token to resume from.
+type FavouriteChangesResponse struct {
+	Changes   []FavouriteChangeResponse `json:"changes"`
+	NextToken string                    `json:"next_token"`
+	HasMore   bool                      `json:"has_more"`
+}
+
+// newFavouriteChangesResponse maps a change feed page to its response shape.
+func newFavouriteChangesResponse(changes []domain.FavouriteChange, next string, more bool) FavouriteChangesResponse {
+	out := make([]FavouriteChangeResponse, 0, len(changes))
+	for _, c := range changes {
+		item := FavouriteChangeResponse{
+			Type:    string(c.Kind),
+			AssetID: c.AssetID,
+			At:      c.At.UTC().Format(time.RFC3339Nano),
+		}
+		if c.Favourite != nil {
+			fav := newFavouriteItemResponse(*c.Favourite)
+			item.Favourite = &fav
+		}
+		out = append(out, item)
+	}
+	return FavouriteChangesResponse{Changes: out, NextToken: next, HasMore: more}
+}
+
+// FavouriteEventResponse is the data of a favourite event on the live stream. Favourite is set for
+// additions and note edits.
+type FavouriteEventResponse struct {
+	Type      string                           `json:"type" example:"added" enums:"added,removed,note_edited"`
+	AssetID   uuid.UUID                        `json:"asset_id" example:"aaaaaaa1-0000-0000-0000-000000000001"`
+	At        string                           `json:"at" example:"2025-09-08T12:34:56Z"`
+	Favourite *FavouriteEventFavouriteResponse `json:"favourite,omitempty"`
+}
+
+// FavouriteEventFavouriteResponse is the favourite of a live event. The note is private and left out;
+// read it with GET /users/{user_id}/favourites/{asset_id}.
+type FavouriteEventFavouriteResponse struct {
+	UserID    uuid.UUID `json:"user_id"  example:"11111111-1111-1111-1111-111111111111"`
+	AssetID   uuid.UUID `json:"asset_id" example:"aaaaaaa1-0000-0000-0000-000000000001"`
+	CreatedAt string    `json:"created_at" example:"2025-09-08T12:34:56Z"`
+}
+
+// newFavouriteEventResponse maps a live favourite event to its response shape.
+func newFavouriteEventResponse(e domain.FavouriteEvent) FavouriteEventResponse {
+	resp := FavouriteEventResponse{
+		Type:    string(e.Kind),
+		AssetID: e.AssetID,
+		At:      e.At.UTC().Format(time.RFC3339Nano),
+	}
+	if e.Favourite != nil {
+		resp.Favourite = &FavouriteEventFavouriteResponse{
+			UserID:    e.Favourite.UserID,
+			AssetID:   e.Favourite.AssetID,
+			CreatedAt: e.Favourite.CreatedAt.UTC().Format(time.RFC3339),
+		}
+	}
+	return resp
+}
+
+// FavouriteBatchRequest is the body for the bulk favourite endpoints.
+type FavouriteBatchRequest struct {
+	AssetIDs []string `json:"asset_ids" example:"aaaaaaa1-0000-0000-0000-000000000001,aaaaaaa2-0000-0000-0000-000000000002"`
+}
+
+// FavouriteBatchItemResponse is the outcome for one asset of a bulk operation.
+type FavouriteBatchItemResponse struct {
+	AssetID uuid.UUID `json:"asset_id" example:"aaaaaaa1-0000-0000-0000-000000000001"`
+	Result  string    `json:"result" example:"created" enums:"created,already_exists,asset_not_found,asset_archived,removed,not_found"`
+}
+
+// FavouriteBatchResponse lists the per-asset outcomes of a bulk operation.
+type FavouriteBatchResponse struct {
+	Results []FavouriteBatchItemResponse `json:"results"`
+}
+
+// newFavouriteBatchResponse maps domain results to their response shape.
+func newFavouriteBatchResponse(results []domain.FavouriteResult) FavouriteBatchResponse {
+	out := make([]FavouriteBatchItemResponse, 0, len(results))
+	for _, r := range results {
+		out = append(out, FavouriteBatchItemResponse{AssetID: r.AssetID, Result: string(r.Outcome)})
+	}
+	return FavouriteBatchResponse{Results: out}
+}
+
+// --- Collections ---
+
+// CollectionRequest is the body for creating or renaming a collection.
+type CollectionRequest struct {
+	Name string `json:"name" example:"Q3 deck"`
+}
+
+// CollectionResponse is returned when collections are requested.
+type CollectionResponse struct {
+	ID        uuid.UUID `json:"id" example:"d1c2b3a4-0000-0000-0000-000000000001"`
+	UserID    uuid.UUID `json:"user_id" example:"11111111-1111-1111-1111-111111111111"`
+	Name      string    `json:"name" example:"Q3 deck"`
+	CreatedAt string    `json:"created_at" example:"2025-09-08T12:34:56Z"`
+}
+
+// CollectionsListResponse wraps a user's collections.
+type CollectionsListResponse struct {
+	Items []CollectionResponse `json:"items"`
 }
 
 // HealthResponse is used by GET /api/healthz.
 type HealthResponse struct {
 	OK bool `json:"ok" example:"true"`
 }
+
+// --- Webhooks ---
+
+// WebhookCreateRequest is the body for registering a webhook receiver.
+type WebhookCreateRequest struct {
+	URL    string   `json:"url" example:"https://crm.example.com/hooks/favourites"`
+	Events []string `json:"events" example:"favourite.added,favourite.removed"`
+}
+
+// WebhookUpdateRequest is the body for changing a webhook subscription. Omitted fields are kept.
+type WebhookUpdateRequest struct {
+	URL    *string  `json:"url,omitempty" example:"https://crm.example.com/hooks/favourites"`
+	Events []string `json:"events,omitempty" example:"favourite.added"`
+	Active *bool    `json:"active,omitempty" example:"false"`
+}
+
+// WebhookResponse is returned when webhook subscriptions are requested. The secret is only
+// returned when the subscription is created.
+type WebhookResponse struct {
+	ID        uuid.UUID `json:"id" example:"e1000000-0000-0000-0000-000000000001"`
+	URL       string    `json:"url" example:"https://crm.example.com/hooks/favourites"`
+	Events    []string  `json:"events" example:"favourite.added,favourite.removed"`
+	Active    bool      `json:"active" example:"true"`
+	CreatedAt string    `json:"created_at" example:"2025-09-08T12:34:56Z"`
+	Secret    string    `json:"secret,omitempty" example:"whsec_5f0c..."`
+}
+
+// WebhooksListResponse wraps all webhook subscriptions.
+type WebhooksListResponse struct {
+	Items []WebhookResponse `json:"items"`
+}
+
+// WebhookDeliveryResponse is one entry of a subscription's delivery log.
+type WebhookDeliveryResponse struct {
+	ID             uuid.UUID       `json:"id" example:"e2000000-0000-0000-0000-000000000001"`
+	EventID        uuid.UUID       `json:"event_id" example:"e3000000-0000-0000-0000-000000000001"`
+	EventType      string          `json:"event_type" example:"favourite.added"`
+	Status         string          `json:"status" example:"pending" enums:"pending,succeeded,failed"`
+	Attempts       int             `json:"attempts" example:"2"`
+	NextAttemptAt  *string         `json:"next_attempt_at,omitempty" example:"2025-09-08T12:35:56Z"`
+	LastStatusCode *int            `json:"last_status_code,omitempty" example:"503"`
+	LastError      string          `json:"last_error,omitempty" example:"receiver answered 503"`
+	DeliveredAt    *string         `json:"delivered_at,omitempty" example:"2025-09-08T12:34:57Z"`
+	CreatedAt      string          `json:"created_at" example:"2025-09-08T12:34:56Z"`
+	Payload        json.RawMessage `json:"payload" swaggertype:"object"`
+}
+
+// WebhookDeliveriesResponse is a page of a delivery log.
+type WebhookDeliveriesResponse struct {
+	Items     []WebhookDeliveryResponse `json:"items"`
+	NextAfter *string                   `json:"next_after,omitempty"`
+}
+
+// --- Audit ---
+
+// AuditEntryResponse is one recorded change. before is omitted for creations, after for deletions.
+type AuditEntryResponse struct {
+	ID             uuid.UUID      `json:"id" example:"a1000000-0000-0000-0000-000000000001"`
+	At             string         `json:"at" example:"2025-09-08T12:34:56.123456Z"`
+	ClaimedActorID *uuid.UUID     `json:"claimed_actor_id,omitempty" example:"11111111-1111-1111-1111-111111111111"`
+	RequestID      string         `json:"request_id,omitempty" example:"host/abcdef-000001"`
+	Action         string         `json:"action" example:"asset.updated"`
+	EntityType     string         `json:"entity_type" example:"asset" enums:"asset,favourite"`
+	EntityID       uuid.UUID      `json:"entity_id" example:"aaaaaaa1-0000-0000-0000-000000000001"`
+	AssetID        *uuid.UUID     `json:"asset_id,omitempty" example:"aaaaaaa1-0000-0000-0000-000000000001"`
+	UserID         *uuid.UUID     `json:"user_id,omitempty" example:"11111111-1111-1111-1111-111111111111"`
+	Before         map[string]any `json:"before,omitempty" swaggertype:"object"`
+	After          map[string]any `json:"after,omitempty" swaggertype:"object"`
+}
+
+// AuditListResponse is a page of the audit log.
+type AuditListResponse struct {
+	Items     []AuditEntryResponse `json:"items"`
+	NextAfter *string              `json:"next_after,omitempty"`
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/google/uuid"
+)
+
+func TestNewFavouriteItemResponse(t *testing.T) {
+	item := domain.FavouritedAsset{
+		Favourite: domain.Favourite{
+			ID:        uuid.New(),
+			Note:      "Use in Q3 deck",
+			CreatedAt: time.Date(2025, 9, 8, 14, 34, 56, 0, time.FixedZone("EEST", 3*60*60)),
+		},
+		Asset: domain.Asset{
+			ID:          uuid.New(),
+			Type:        domain.AssetTypeInsight,
+			Description: "Social media usage",
+			Payload:     domain.InsightPayload{Text: "40% of millennials spend more than 3 hours on social media daily"},
+			Version:     1,
+		},
+	}
+
+	got := newFavouriteItemResponse(item)
+	if got.ID != item.Asset.ID || got.FavouriteID != item.Favourite.ID || got.Note != item.Favourite.Note {
+		t.Fatalf("newFavouriteItemResponse() = %+v, want asset %s, favourite %s", got, item.Asset.ID, item.Favourite.ID)
+	}
+	if got.FavouritedAt != "2025-09-08T11:34:56Z" {
+		t.Fatalf("newFavouriteItemResponse().FavouritedAt = %q, want UTC RFC 3339", got.FavouritedAt)
+	}
+
+	// Total is opt-in, so it's omitted unless set.
+	b, err := json.Marshal(AssetsListResponse{Items: []FavouriteItemResponse{got}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(b, &fields); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := fields["total"]; ok {
+		t.Fatalf("AssetsListResponse without a total encoded %s", b)
+	}
+}
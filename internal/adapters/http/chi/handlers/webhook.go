@@ -0,0 +1,319 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/app"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+)
+
+// WebhookHandler serves webhook subscription endpoints.
+type WebhookHandler struct {
+	webhookService *app.WebhookService
+}
+
+func NewWebhookHandler(webhookService *app.WebhookService) *WebhookHandler {
+	return &WebhookHandler{webhookService: webhookService}
+}
+
+// List godoc
+// @Summary      List webhooks
+// @Description  Returns all webhook subscriptions. Secrets are not included.
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  handlers.WebhooksListResponse
+// @Failure      500  {object}  handlers.ErrorResponse
+// @Router       /webhooks [get]
+func (handler *WebhookHandler) List(writer http.ResponseWriter, req *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	subs, err := handler.webhookService.List(req.Context())
+	if err != nil {
+		writeWebhookError(writer, err)
+		return
+	}
+
+	out := make([]WebhookResponse, 0, len(subs))
+	for _, s := range subs {
+		out = append(out, newWebhookResponse(s, false))
+	}
+
+	_ = json.NewEncoder(writer).Encode(WebhooksListResponse{Items: out})
+}
+
+// Create godoc
+// @Summary      Create webhook
+// @Description  Registers a receiver URL for events: favourite.added, favourite.removed, asset.description_edited.
+// @Description  Every delivery is a JSON POST signed with the returned secret (shown only here): X-Webhook-Signature
+// @Description  is "sha256=" + hex HMAC-SHA256 of "<X-Webhook-Timestamp>.<body>". Deliveries not answered with 2xx
+// @Description  are retried with exponential backoff, up to 8 attempts. The URL's host must resolve to public
+// @Description  addresses; loopback, private and link-local receivers are refused.
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        payload  body     handlers.WebhookCreateRequest  true  "Webhook payload"
+// @Success      201      {object} handlers.WebhookResponse
+// @Failure      400      {object} handlers.ErrorResponse
+// @Failure      500      {object} handlers.ErrorResponse
+// @Router       /webhooks [post]
+func (handler *WebhookHandler) Create(writer http.ResponseWriter, req *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	var body WebhookCreateRequest
+
+	dec := json.NewDecoder(req.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&body); err != nil {
+		WriteJsonError(writer, "invalid json", http.StatusBadRequest)
+		return
+	}
+
+	s, err := handler.webhookService.Create(req.Context(), body.URL, toEventTypes(body.Events))
+	if err != nil {
+		writeWebhookError(writer, err)
+		return
+	}
+
+	writer.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(writer).Encode(newWebhookResponse(s, true))
+}
+
+// Get godoc
+// @Summary      Get webhook
+// @Description  Returns a webhook subscription. The secret is not included.
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        webhook_id  path      string  true  "Webhook ID (UUID)"
+// @Success      200         {object}  handlers.WebhookResponse
+// @Failure      400         {object}  handlers.ErrorResponse
+// @Failure      404         {object}  handlers.ErrorResponse
+// @Failure      500         {object}  handlers.ErrorResponse
+// @Router       /webhooks/{webhook_id} [get]
+func (handler *WebhookHandler) Get(writer http.ResponseWriter, req *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	id, ok := parseUUIDParam(writer, req, "webhook_id")
+	if !ok {
+		return
+	}
+
+	s, err := handler.webhookService.Get(req.Context(), id)
+	if err != nil {
+		writeWebhookError(writer, err)
+		return
+	}
+
+	_ = json.NewEncoder(writer).Encode(newWebhookResponse(*s, false))
+}
+
+// Update godoc
+// @Summary      Update webhook
+// @Description  Changes the URL, the events and/or pauses (active=false) a webhook subscription.
+// @Description  Paused subscriptions get no new deliveries; already queued ones are still attempted.
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        webhook_id  path     string                         true  "Webhook ID (UUID)"
+// @Param        payload     body     handlers.WebhookUpdateRequest  true  "Fields to change"
+// @Success      200         {object} handlers.WebhookResponse
+// @Failure      400         {object} handlers.ErrorResponse
+// @Failure      404         {object} handlers.ErrorResponse
+// @Failure      500         {object} handlers.ErrorResponse
+// @Router       /webhooks/{webhook_id} [patch]
+func (handler *WebhookHandler) Update(writer http.ResponseWriter, req *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	id, ok := parseUUIDParam(writer, req, "webhook_id")
+	if !ok {
+		return
+	}
+
+	var body WebhookUpdateRequest
+
+	dec := json.NewDecoder(req.Body)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&body); err != nil {
+		WriteJsonError(writer, "invalid json", http.StatusBadRequest)
+		return
+	}
+
+	var eventTypes []domain.WebhookEventType
+	if body.Events != nil {
+		eventTypes = toEventTypes(body.Events)
+	}
+	s, err := handler.webhookService.Update(req.Context(), id, body.URL, eventTypes, body.Active)
+	if err != nil {
+		writeWebhookError(writer, err)
+		return
+	}
+
+	_ = json.NewEncoder(writer).Encode(newWebhookResponse(*s, false))
+}
+
+// Delete godoc
+// @Summary      Delete webhook
+// @Description  Deletes a webhook subscription together with its delivery log.
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        webhook_id  path   string  true  "Webhook ID (UUID)"
+// @Success      204
+// @Failure      400         {object} handlers.ErrorResponse
+// @Failure      404         {object} handlers.ErrorResponse
+// @Failure      500         {object} handlers.ErrorResponse
+// @Router       /webhooks/{webhook_id} [delete]
+func (handler *WebhookHandler) Delete(writer http.ResponseWriter, req *http.Request) {
+	id, ok := parseUUIDParam(writer, req, "webhook_id")
+	if !ok {
+		return
+	}
+
+	if err := handler.webhookService.Delete(req.Context(), id); err != nil {
+		writeWebhookError(writer, err)
+		return
+	}
+
+	writer.WriteHeader(http.StatusNoContent)
+}
+
+// Deliveries godoc
+// @Summary      List webhook deliveries
+// @Description  Returns the delivery log of a webhook subscription, newest first, using keyset pagination.
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        webhook_id  path   string  true   "Webhook ID (UUID)"
+// @Param        limit       query  int     false  "Max items to return (default 20, max 50)"
+// @Param        after       query  string  false  "Opaque cursor from next_after"
+// @Success      200         {object}  handlers.WebhookDeliveriesResponse
+// @Failure      400         {object}  handlers.ErrorResponse
+// @Failure      404         {object}  handlers.ErrorResponse
+// @Failure      500         {object}  handlers.ErrorResponse
+// @Router       /webhooks/{webhook_id}/deliveries [get]
+func (handler *WebhookHandler) Deliveries(writer http.ResponseWriter, req *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	id, ok := parseUUIDParam(writer, req, "webhook_id")
+	if !ok {
+		return
+	}
+
+	limit, _, ok := parsePagination(writer, req)
+	if !ok {
+		return
+	}
+
+	items, nextAfter, err := handler.webhookService.Deliveries(req.Context(), id, limit, req.URL.Query().Get("after"))
+	if err != nil {
+		writeWebhookError(writer, err)
+		return
+	}
+
+	out := make([]WebhookDeliveryResponse, 0, len(items))
+	for _, d := range items {
+		out = append(out, newWebhookDeliveryResponse(d))
+	}
+
+	_ = json.NewEncoder(writer).Encode(WebhookDeliveriesResponse{Items: out, NextAfter: nextAfter})
+}
+
+// Ping godoc
+// @Summary      Ping webhook
+// @Description  Queues a "ping" event for the subscription, even when it is paused, to check its receiver.
+// @Description  Follow the outcome in the delivery log.
+// @Tags         webhooks
+// @Accept       json
+// @Produce      json
+// @Param        webhook_id  path   string  true  "Webhook ID (UUID)"
+// @Success      202         {object} handlers.WebhookDeliveryResponse
+// @Failure      400         {object} handlers.ErrorResponse
+// @Failure      404         {object} handlers.ErrorResponse
+// @Failure      500         {object} handlers.ErrorResponse
+// @Router       /webhooks/{webhook_id}/ping [post]
+func (handler *WebhookHandler) Ping(writer http.ResponseWriter, req *http.Request) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	id, ok := parseUUIDParam(writer, req, "webhook_id")
+	if !ok {
+		return
+	}
+
+	d, err := handler.webhookService.Ping(req.Context(), id)
+	if err != nil {
+		writeWebhookError(writer, err)
+		return
+	}
+
+	writer.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(writer).Encode(newWebhookDeliveryResponse(d))
+}
+
+func writeWebhookError(writer http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrWebhookNotFound):
+		WriteJsonError(writer, "webhook not found", http.StatusNotFound)
+	case errors.Is(err, domain.ErrInvalidWebhookURL), errors.Is(err, domain.ErrInvalidWebhookEvents):
+		WriteJsonError(writer, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, domain.ErrBadCursor):
+		WriteJsonError(writer, "bad cursor", http.StatusBadRequest)
+	default:
+		WriteJsonError(writer, "internal error", http.StatusInternalServerError)
+	}
+}
+
+// toEventTypes converts requested event names; the domain validates them.
+func toEventTypes(events []string) []domain.WebhookEventType {
+	out := make([]domain.WebhookEventType, 0, len(events))
+	for _, e := range events {
+		out = append(out, domain.WebhookEventType(e))
+	}
+	return out
+}
+
+// newWebhookResponse maps a domain subscription to its response shape, with the secret only when asked.
+func newWebhookResponse(s domain.WebhookSubscription, withSecret bool) WebhookResponse {
+	events := make([]string, 0, len(s.EventTypes))
+	for _, t := range s.EventTypes {
+		events = append(events, string(t))
+	}
+	resp := WebhookResponse{
+		ID:        s.ID,
+		URL:       s.URL,
+		Events:    events,
+		Active:    s.Active,
+		CreatedAt: s.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	if withSecret {
+		resp.Secret = s.Secret
+	}
+	return resp
+}
+
+// newWebhookDeliveryResponse maps a domain delivery to its response shape.
+func newWebhookDeliveryResponse(d domain.WebhookDelivery) WebhookDeliveryResponse {
+	resp := WebhookDeliveryResponse{
+		ID:             d.ID,
+		EventID:        d.EventID,
+		EventType:      string(d.EventType),
+		Status:         string(d.Status),
+		Attempts:       d.Attempts,
+		LastStatusCode: d.LastStatusCode,
+		LastError:      d.LastError,
+		CreatedAt:      d.CreatedAt.UTC().Format(time.RFC3339),
+		Payload:        d.Payload,
+	}
+	if d.Status == domain.DeliveryPending {
+		next := d.NextAttemptAt.UTC().Format(time.RFC3339)
+		resp.NextAttemptAt = &next
+	}
+	if d.DeliveredAt != nil {
+		delivered := d.DeliveredAt.UTC().Format(time.RFC3339)
+		resp.DeliveredAt = &delivered
+	}
+	return resp
+}
@@ -1,57 +1,154 @@
 package chihttp
 
 import (
+	"expvar"
 	"net/http"
 	"time"
 
 	_ "github.com/SokratisChaimanas/platform-go-challenge/docs"
 	"github.com/SokratisChaimanas/platform-go-challenge/internal/adapters/http/chi/handlers"
 	"github.com/SokratisChaimanas/platform-go-challenge/internal/app"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
 	httpSwagger "github.com/swaggo/http-swagger/v2"
 )
 
-// NewRouter builds the chi router and mounts all routes.
+// NewRouter builds the chi router and mounts all routes. Admin routes require adminToken.
 func NewRouter(
 	userService *app.UserService,
 	assetService *app.AssetService,
 	favService *app.FavouritesService,
+	collectionService *app.CollectionService,
+	recService *app.RecommendationService,
+	webhookService *app.WebhookService,
+	auditService *app.AuditService,
+	adminToken string,
 ) http.Handler {
 	router := chi.NewRouter()
 
 	// Basic middlewares.
 	router.Use(middleware.RequestID)
+	router.Use(auditContext)
 	router.Use(middleware.RealIP)
 	router.Use(middleware.Logger)
 	router.Use(middleware.Recoverer)
-	router.Use(middleware.Timeout(30 * time.Second))
 
+	// Event streams stay open indefinitely and exports can run long, so they are mounted outside
+	// the request timeout; both push the server's write deadline forward as they write.
+	favouritesHandler := handlers.NewFavouritesHandler(favService)
+	router.Get("/api/users/{user_id}/favourites/stream", favouritesHandler.Stream)
+	router.Get("/api/users/{user_id}/favourites/export", favouritesHandler.Export)
+
+	router.Group(func(router chi.Router) {
+		router.Use(middleware.Timeout(30 * time.Second))
+		mountAPI(router, userService, assetService, favouritesHandler, favService, collectionService, recService, webhookService)
+
+		// Admin.
+		auditHandler := handlers.NewAuditHandler(auditService)
+		router.With(handlers.RequireAdmin(adminToken)).Get("/api/audit", auditHandler.List)
+	})
+
+	// 404 fallback.
+	router.NotFound(func(w http.ResponseWriter, _ *http.Request) {
+		handlers.WriteJsonError(w, "not found", http.StatusNotFound)
+	})
+
+	router.Get("/docs/*", httpSwagger.WrapHandler)
+
+	// Runtime and outbox relay metrics (pending, lag_seconds, ...) as JSON. They include the command
+	// line and memory statistics, so they are an admin endpoint.
+	router.With(handlers.RequireAdmin(adminToken)).Method(http.MethodGet, "/debug/vars", expvar.Handler())
+
+	return router
+}
+
+// mountAPI mounts the request/response API routes.
+func mountAPI(
+	router chi.Router,
+	userService *app.UserService,
+	assetService *app.AssetService,
+	favouritesHandler *handlers.FavouritesHandler,
+	favService *app.FavouritesService,
+	collectionService *app.CollectionService,
+	recService *app.RecommendationService,
+	webhookService *app.WebhookService,
+) {
 	// Health.
 	healthHandler := handlers.NewHealthHandler()
 	router.Method(http.MethodGet, "/api/healthz", healthHandler)
 
 	// Users.
 	userHandler := handlers.NewUserHandler(userService)
+	recommendationHandler := handlers.NewRecommendationHandler(recService)
 	router.Route("/api/users", func(r chi.Router) {
 		r.Get("/{user_id}", userHandler.Get)
+		r.Get("/{user_id}/recommendations", recommendationHandler.ForUser)
 		// Favourites
-		favouritesHandler := handlers.NewFavouritesHandler(favService)
 		r.Get("/{user_id}/favourites", favouritesHandler.ListByUser)
 		r.Post("/{user_id}/favourites", favouritesHandler.Add)
+		r.Get("/{user_id}/favourites/status", favouritesHandler.Status)
+		r.Get("/{user_id}/favourites/changes", favouritesHandler.Changes)
+		r.Post("/{user_id}/favourites/import", favouritesHandler.Import)
+		r.Get("/{user_id}/favourites/{asset_id}", favouritesHandler.Get)
+		r.Put("/{user_id}/favourites/{asset_id}", favouritesHandler.Put)
+		r.Post("/{user_id}/favourites/batch", favouritesHandler.AddMany)
+		r.Post("/{user_id}/favourites/batch/remove", favouritesHandler.RemoveMany)
+		r.Patch("/{user_id}/favourites/{asset_id}", favouritesHandler.EditNote)
 		r.Delete("/{user_id}/favourites/{asset_id}", favouritesHandler.Remove)
+		// Collections
+		collectionHandler := handlers.NewCollectionHandler(collectionService)
+		r.Get("/{user_id}/collections", collectionHandler.List)
+		r.Post("/{user_id}/collections", collectionHandler.Create)
+		r.Get("/{user_id}/collections/{collection_id}", collectionHandler.Get)
+		r.Patch("/{user_id}/collections/{collection_id}", collectionHandler.Rename)
+		r.Delete("/{user_id}/collections/{collection_id}", collectionHandler.Delete)
+		r.Put("/{user_id}/collections/{collection_id}/favourites/{asset_id}", collectionHandler.AddFavourite)
+		r.Delete("/{user_id}/collections/{collection_id}/favourites/{asset_id}", collectionHandler.RemoveFavourite)
 	})
 
 	// Assets.
 	assetHandler := handlers.NewAssetHandler(assetService)
-	router.Patch("/api/assets/{asset_id}/description", assetHandler.EditDescription)
-
-	// 404 fallback.
-	router.NotFound(func(w http.ResponseWriter, _ *http.Request) {
-		handlers.WriteJsonError(w, "not found", http.StatusNotFound)
+	popularityHandler := handlers.NewPopularityHandler(favService)
+	router.Route("/api/assets", func(r chi.Router) {
+		r.Get("/", assetHandler.List)
+		r.Post("/", assetHandler.Create)
+		r.Get("/popular", popularityHandler.Popular)
+		r.Get("/trending", popularityHandler.Trending)
+		r.Get("/{asset_id}", assetHandler.Get)
+		r.Patch("/{asset_id}", assetHandler.Patch)
+		r.Delete("/{asset_id}", assetHandler.Delete)
+		r.Post("/{asset_id}/archive", assetHandler.Archive)
+		r.Post("/{asset_id}/restore", assetHandler.Restore)
+		r.Get("/{asset_id}/related", recommendationHandler.Related)
+		r.Get("/{asset_id}/revisions", assetHandler.ListRevisions)
+		r.Post("/{asset_id}/revisions/{revision_id}/revert", assetHandler.RevertRevision)
+		r.Patch("/{asset_id}/description", assetHandler.EditDescription)
 	})
 
-	router.Get("/docs/*", httpSwagger.WrapHandler)
+	// Webhooks.
+	webhookHandler := handlers.NewWebhookHandler(webhookService)
+	router.Route("/api/webhooks", func(r chi.Router) {
+		r.Get("/", webhookHandler.List)
+		r.Post("/", webhookHandler.Create)
+		r.Get("/{webhook_id}", webhookHandler.Get)
+		r.Patch("/{webhook_id}", webhookHandler.Update)
+		r.Delete("/{webhook_id}", webhookHandler.Delete)
+		r.Get("/{webhook_id}/deliveries", webhookHandler.Deliveries)
+		r.Post("/{webhook_id}/ping", webhookHandler.Ping)
+	})
+}
 
-	return router
+// auditContext records who the request claims to come from (the ActorHeader, when it holds a UUID)
+// and its request ID in the context, for the audit entries of the changes made while serving it.
+// Users are not authenticated, so the actor is only ever recorded as claimed.
+func auditContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		ac := domain.AuditContext{RequestID: middleware.GetReqID(req.Context())}
+		if id, err := uuid.Parse(req.Header.Get(handlers.ActorHeader)); err == nil {
+			ac.ClaimedActorID = &id
+		}
+		next.ServeHTTP(writer, req.WithContext(domain.WithAuditContext(req.Context(), ac)))
+	})
 }
@@ -0,0 +1,81 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// ErrForbiddenAddress is returned for receivers on loopback, private, link-local or other
+// non-public addresses, so a webhook can't be used to reach the server's own network.
+var ErrForbiddenAddress = errors.New("webhook receivers must have a public address")
+
+// checkAddr returns ErrForbiddenAddress unless ip is a public unicast address.
+func checkAddr(ip netip.Addr) error {
+	ip = ip.Unmap()
+	// IsGlobalUnicast excludes loopback, link-local (e.g. cloud metadata at 169.254.169.254),
+	// multicast and unspecified addresses; IsPrivate covers 10/8, 172.16/12, 192.168/16 and fc00::/7.
+	if !ip.IsGlobalUnicast() || ip.IsPrivate() {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, ip)
+	}
+	return nil
+}
+
+// NewHTTPClient returns the client deliveries should be sent with: every connection, including
+// redirects, is checked at dial time, after DNS resolution, so a receiver host that later resolves
+// to a private address is refused too. Proxies from the environment are not used, since they
+// would dial on the client's behalf.
+func NewHTTPClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   timeout,
+		KeepAlive: 30 * time.Second,
+		Control:   dialControl,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{Timeout: timeout, Transport: transport}
+}
+
+// dialControl vets the resolved address of each connection before it is made.
+func dialControl(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	return checkAddr(ip)
+}
+
+// CheckURL refuses receiver URLs whose host is, or resolves to, a non-public address. It runs at
+// registration; the client from NewHTTPClient checks again when connecting, as DNS can change.
+func (sender *HTTPSender) CheckURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	host := u.Hostname()
+	if ip, err := netip.ParseAddr(host); err == nil {
+		return checkAddr(ip)
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return fmt.Errorf("cannot resolve host %q", host)
+	}
+	for _, ip := range addrs {
+		if err := checkAddr(ip); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+func TestCheckAddr(t *testing.T) {
+	tests := []struct {
+		addr    string
+		allowed bool
+	}{
+		{addr: "93.184.215.14", allowed: true},
+		{addr: "2606:4700::1111", allowed: true},
+		{addr: "127.0.0.1"},
+		{addr: "::1"},
+		{addr: "10.1.2.3"},
+		{addr: "172.16.0.1"},
+		{addr: "192.168.1.1"},
+		{addr: "169.254.169.254"},
+		{addr: "fe80::1"},
+		{addr: "fd00::1"},
+		{addr: "0.0.0.0"},
+		{addr: "224.0.0.1"},
+		{addr: "::ffff:127.0.0.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			err := checkAddr(netip.MustParseAddr(tt.addr))
+			if tt.allowed && err != nil {
+				t.Fatalf("checkAddr(%s) = %v, want allowed", tt.addr, err)
+			}
+			if !tt.allowed && !errors.Is(err, ErrForbiddenAddress) {
+				t.Fatalf("checkAddr(%s) = %v, want ErrForbiddenAddress", tt.addr, err)
+			}
+		})
+	}
+}
+
+func TestCheckURL(t *testing.T) {
+	sender := NewHTTPSender(http.DefaultClient)
+	ctx := context.Background()
+
+	for _, rawURL := range []string{
+		"http://127.0.0.1:8080/hook",
+		"https://[::1]/hook",
+		"http://169.254.169.254/latest/meta-data/",
+		"http://10.0.0.5/hook",
+	} {
+		if err := sender.CheckURL(ctx, rawURL); !errors.Is(err, ErrForbiddenAddress) {
+			t.Errorf("CheckURL(%s) = %v, want ErrForbiddenAddress", rawURL, err)
+		}
+	}
+	if err := sender.CheckURL(ctx, "https://93.184.215.14/hook"); err != nil {
+		t.Errorf("CheckURL(public address) = %v, want nil", err)
+	}
+}
+
+func TestHTTPClientRefusesLoopbackAtDialTime(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	resp, err := NewHTTPClient(time.Second).Get(server.URL)
+	if err == nil {
+		resp.Body.Close()
+	}
+	if !errors.Is(err, ErrForbiddenAddress) {
+		t.Fatalf("Get(%s) error = %v, want ErrForbiddenAddress", server.URL, err)
+	}
+	if called {
+		t.Fatal("the loopback receiver was reached")
+	}
+}
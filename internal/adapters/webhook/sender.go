@@ -0,0 +1,76 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/ports"
+)
+
+// Delivery request headers.
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// maxResponseBytes is how much of a receiver's response body is read before the connection is reused.
+const maxResponseBytes = 64 << 10
+
+// Ensure ports.WebhookSender interface implementation.
+var _ ports.WebhookSender = (*HTTPSender)(nil)
+
+// HTTPSender posts webhook deliveries with an injected *http.Client, so timeouts and transports
+// (or a test server's client) are chosen by the caller.
+type HTTPSender struct {
+	client *http.Client
+}
+
+func NewHTTPSender(client *http.Client) *HTTPSender {
+	return &HTTPSender{client: client}
+}
+
+// Send posts the payload with its event, delivery ID, timestamp and signature headers.
+func (sender *HTTPSender) Send(ctx context.Context, s domain.WebhookSubscription, d domain.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "platform-go-challenge-webhooks")
+	req.Header.Set(HeaderEvent, string(d.EventType))
+	req.Header.Set(HeaderDelivery, d.ID.String())
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(s.Secret, timestamp, d.Payload))
+
+	resp, err := sender.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBytes))
+
+	return resp.StatusCode, nil
+}
+
+// Sign returns the signature header value for a payload: "sha256=" followed by the hex
+// HMAC-SHA256, keyed with the subscription secret, of "<timestamp>.<payload>". Receivers
+// recompute it to authenticate a delivery and check the timestamp to reject replays.
+func Sign(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
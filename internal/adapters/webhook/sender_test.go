@@ -0,0 +1,96 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/google/uuid"
+)
+
+func TestSendSignsTheDelivery(t *testing.T) {
+	sub := domain.WebhookSubscription{ID: uuid.New(), Secret: "whsec_test"}
+	d := domain.NewWebhookDelivery(sub.ID, uuid.New(), domain.WebhookFavouriteAdded, []byte(`{"id":"1","type":"favourite.added"}`))
+	d.ID = uuid.New()
+
+	var got *http.Request
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+	sub.URL = server.URL + "/hooks"
+
+	before := time.Now().Unix()
+	status, err := NewHTTPSender(server.Client()).Send(context.Background(), sub, d)
+	if err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if status != http.StatusAccepted {
+		t.Fatalf("status = %d, want %d", status, http.StatusAccepted)
+	}
+
+	if got.Method != http.MethodPost || got.URL.Path != "/hooks" {
+		t.Fatalf("request = %s %s, want POST /hooks", got.Method, got.URL.Path)
+	}
+	if string(body) != string(d.Payload) {
+		t.Fatalf("body = %s, want %s", body, d.Payload)
+	}
+	if h := got.Header.Get(HeaderEvent); h != string(domain.WebhookFavouriteAdded) {
+		t.Errorf("%s = %q", HeaderEvent, h)
+	}
+	if h := got.Header.Get(HeaderDelivery); h != d.ID.String() {
+		t.Errorf("%s = %q, want %s", HeaderDelivery, h, d.ID)
+	}
+
+	timestamp, err := strconv.ParseInt(got.Header.Get(HeaderTimestamp), 10, 64)
+	if err != nil || timestamp < before || timestamp > time.Now().Unix() {
+		t.Fatalf("%s = %q, want the send time", HeaderTimestamp, got.Header.Get(HeaderTimestamp))
+	}
+
+	// Recompute the signature the way a receiver would: HMAC-SHA256 over "<timestamp>.<body>".
+	mac := hmac.New(sha256.New, []byte(sub.Secret))
+	mac.Write([]byte(got.Header.Get(HeaderTimestamp) + "." + string(body)))
+	want := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	if h := got.Header.Get(HeaderSignature); h != want {
+		t.Fatalf("%s = %q, want %q", HeaderSignature, h, want)
+	}
+}
+
+func TestSendReportsReceiverErrors(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			<-release
+			return
+		}
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+	defer close(release) // before Close, which waits for the handler
+
+	client := server.Client()
+	client.Timeout = 50 * time.Millisecond
+	sender := NewHTTPSender(client)
+	d := domain.NewWebhookDelivery(uuid.New(), uuid.New(), domain.WebhookPing, []byte(`{}`))
+
+	status, err := sender.Send(context.Background(), domain.WebhookSubscription{URL: server.URL + "/fail"}, d)
+	if err != nil || status != http.StatusBadGateway {
+		t.Fatalf("Send(5xx) = %d, %v; want %d, nil", status, err, http.StatusBadGateway)
+	}
+
+	status, err = sender.Send(context.Background(), domain.WebhookSubscription{URL: server.URL + "/slow"}, d)
+	if err == nil || status != 0 {
+		t.Fatalf("Send(timeout) = %d, %v; want 0 and an error", status, err)
+	}
+}
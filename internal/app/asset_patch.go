@@ -0,0 +1,129 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	jsonpatch "github.com/evanphx/json-patch/v5"
+)
+
+// PatchKind is the format of a patch document applied to an asset.
+type PatchKind string
+
+const (
+	MergePatch PatchKind = "merge" // JSON Merge Patch, RFC 7396
+	JSONPatch  PatchKind = "json"  // JSON Patch, RFC 6902
+)
+
+// assetDocument is the patchable JSON representation of an asset. It has the shape of the
+// description and payload in the API, so patches address the fields clients see.
+type assetDocument struct {
+	Description string          `json:"description"`
+	Payload     payloadDocument `json:"payload"`
+}
+
+// payloadDocument is the JSON shape of an asset payload; only the fields of the asset type are set.
+type payloadDocument struct {
+	Title  string    `json:"title,omitempty"`
+	X      []string  `json:"x,omitempty"`
+	Y      []float64 `json:"y,omitempty"`
+	Series []string  `json:"series,omitempty"`
+	Values []float64 `json:"values,omitempty"`
+	YLabel string    `json:"y_label,omitempty"`
+
+	Text string `json:"text,omitempty"`
+
+	Gender         string `json:"gender,omitempty"`
+	AgeGroup       string `json:"age_group,omitempty"`
+	Country        string `json:"country,omitempty"`
+	SocialHours    string `json:"social_hours,omitempty"`
+	PurchasesMonth string `json:"purchases_month,omitempty"`
+}
+
+// patchAsset applies patch to the document of a and returns the patched description and payload.
+// The result is not validated: the domain rules do that when the edit is applied.
+func patchAsset(a *domain.Asset, patch []byte, kind PatchKind) (string, domain.AssetPayload, error) {
+	original, err := json.Marshal(newAssetDocument(a))
+	if err != nil {
+		return "", nil, err
+	}
+
+	var patched []byte
+	switch kind {
+	case MergePatch:
+		if !json.Valid(patch) {
+			return "", nil, domain.ErrInvalidPatch
+		}
+		if patched, err = jsonpatch.MergePatch(original, patch); err != nil {
+			return "", nil, domain.ErrInvalidPatch
+		}
+	case JSONPatch:
+		ops, err := jsonpatch.DecodePatch(patch)
+		if err != nil {
+			return "", nil, domain.ErrInvalidPatch
+		}
+		if patched, err = ops.Apply(original); err != nil {
+			return "", nil, fmt.Errorf("%w: %v", domain.ErrPatchConflict, err)
+		}
+	default:
+		return "", nil, fmt.Errorf("%w: unknown patch kind %q", domain.ErrInvalidPatch, kind)
+	}
+
+	var doc assetDocument
+	dec := json.NewDecoder(bytes.NewReader(patched))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&doc); err != nil {
+		return "", nil, fmt.Errorf("%w: patched asset is not a valid asset document", domain.ErrInvalidPatch)
+	}
+	payload, err := doc.Payload.toDomain(a.Type)
+	if err != nil {
+		return "", nil, err // expected: domain.ErrInvalidPayload
+	}
+	return doc.Description, payload, nil
+}
+
+func newAssetDocument(a *domain.Asset) assetDocument {
+	doc := assetDocument{Description: a.Description}
+	switch p := a.Payload.(type) {
+	case domain.ChartPayload:
+		doc.Payload = payloadDocument{Title: p.Title, X: p.X, Y: p.Y, Series: p.Series, Values: p.Values, YLabel: p.YLabel}
+	case domain.InsightPayload:
+		doc.Payload = payloadDocument{Text: p.Text}
+	case domain.AudiencePayload:
+		doc.Payload = payloadDocument{
+			Gender:         p.Gender,
+			AgeGroup:       p.AgeGroup,
+			Country:        p.Country,
+			SocialHours:    p.SocialHours,
+			PurchasesMonth: p.PurchasesMonth,
+		}
+	}
+	return doc
+}
+
+// toDomain maps the document to the typed payload of assetType.
+// Fields that belong to another asset type are rejected.
+func (p payloadDocument) toDomain(assetType domain.AssetType) (domain.AssetPayload, error) {
+	chartSet := p.Title != "" || len(p.X) > 0 || len(p.Y) > 0 || len(p.Series) > 0 || len(p.Values) > 0 || p.YLabel != ""
+	insightSet := p.Text != ""
+	audienceSet := p.Gender != "" || p.AgeGroup != "" || p.Country != "" || p.SocialHours != "" || p.PurchasesMonth != ""
+
+	switch {
+	case assetType == domain.AssetTypeChart && !insightSet && !audienceSet:
+		return domain.ChartPayload{Title: p.Title, X: p.X, Y: p.Y, Series: p.Series, Values: p.Values, YLabel: p.YLabel}, nil
+	case assetType == domain.AssetTypeInsight && !chartSet && !audienceSet:
+		return domain.InsightPayload{Text: p.Text}, nil
+	case assetType == domain.AssetTypeAudience && !chartSet && !insightSet:
+		return domain.AudiencePayload{
+			Gender:         p.Gender,
+			AgeGroup:       p.AgeGroup,
+			Country:        p.Country,
+			SocialHours:    p.SocialHours,
+			PurchasesMonth: p.PurchasesMonth,
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s payload has fields of another asset type", domain.ErrInvalidPayload, assetType)
+	}
+}
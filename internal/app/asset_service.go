@@ -2,6 +2,8 @@ package app
 
 import (
 	"context"
+	"errors"
+	"strings"
 
 	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
 	"github.com/SokratisChaimanas/platform-go-challenge/internal/ports"
@@ -11,25 +13,191 @@ import (
 // AssetService coordinates asset operations (load → mutate → save).
 // Domain enforces rules; ent handles persistence.
 type AssetService struct {
-	assetRepo ports.AssetRepository
+	uow          ports.UnitOfWork
+	assetRepo    ports.AssetRepository
+	revisionRepo ports.AssetRevisionRepository
+	outbox       ports.OutboxRepository
 }
 
-func NewAssetService(assets ports.AssetRepository) *AssetService {
-	return &AssetService{assetRepo: assets}
+func NewAssetService(
+	uow ports.UnitOfWork, assets ports.AssetRepository, revisions ports.AssetRevisionRepository, outbox ports.OutboxRepository,
+) *AssetService {
+	return &AssetService{uow: uow, assetRepo: assets, revisionRepo: revisions, outbox: outbox}
+}
+
+// Create validates the asset (domain rules) and persists it. Returns the created asset.
+func (assetService *AssetService) Create(ctx context.Context, assetType domain.AssetType, description string, payload domain.AssetPayload) (*domain.Asset, error) {
+	a, err := domain.NewAsset(assetType, description, payload)
+	if err != nil {
+		return nil, err // expected: domain.ErrInvalidAssetType, domain.ErrEmptyDescription, domain.ErrInvalidPayload
+	}
+	if err := assetService.assetRepo.Create(ctx, a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Get returns an asset or domain.ErrAssetNotFound.
+func (assetService *AssetService) Get(ctx context.Context, assetID uuid.UUID) (*domain.Asset, error) {
+	return assetService.assetRepo.Get(ctx, assetID)
+}
+
+// List returns the asset catalogue filtered by filter, using a keyset cursor.
+func (assetService *AssetService) List(ctx context.Context, filter ports.AssetFilter, limit int, after string) ([]domain.Asset, *string, error) {
+	if filter.Type != "" && !filter.Type.Valid() {
+		return nil, nil, domain.ErrInvalidAssetType
+	}
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && !filter.CreatedFrom.Before(*filter.CreatedTo) {
+		return nil, nil, domain.ErrInvalidTimeRange
+	}
+	filter.Query = strings.TrimSpace(filter.Query)
+	return assetService.assetRepo.ListKeyset(ctx, filter, limit, after)
 }
 
 // EditDescription loads the asset, edits the description (domain rule),
-// then persists changes. Returns the updated asset.
-func (assetService *AssetService) EditDescription(ctx context.Context, assetID uuid.UUID, newDesc string) (*domain.Asset, error) {
+// persists changes and records a revision. editorID may be nil when unknown;
+// expectedVersions (If-Match) may be nil to skip the precondition.
+// Returns the updated asset.
+func (assetService *AssetService) EditDescription(
+	ctx context.Context, assetID uuid.UUID, newDesc string, editorID *uuid.UUID, expectedVersions []int,
+) (*domain.Asset, error) {
+	a, err := assetService.assetRepo.Get(ctx, assetID)
+	if err != nil {
+		return nil, err // expected: domain.ErrAssetNotFound
+	}
+	if expectedVersions != nil {
+		if err := a.CheckVersion(expectedVersions...); err != nil {
+			return nil, err // expected: domain.ErrAssetVersionMismatch
+		}
+	}
+	return assetService.applyEdit(ctx, a, newDesc, nil, editorID)
+}
+
+// Patch applies a patch document of the given kind to the description and payload of an asset,
+// re-validating the result with the domain rules. The asset is loaded once: the precondition, the
+// patch and the save all see the same version, so a concurrent edit fails the save instead of being
+// overwritten. expectedVersions (If-Match) may be nil to skip the precondition.
+func (assetService *AssetService) Patch(
+	ctx context.Context, assetID uuid.UUID, patchDoc []byte, kind PatchKind, editorID *uuid.UUID, expectedVersions []int,
+) (*domain.Asset, error) {
+	a, err := assetService.assetRepo.Get(ctx, assetID)
+	if err != nil {
+		return nil, err // expected: domain.ErrAssetNotFound
+	}
+	if expectedVersions != nil {
+		if err := a.CheckVersion(expectedVersions...); err != nil {
+			return nil, err // expected: domain.ErrAssetVersionMismatch
+		}
+	}
+	newDesc, payload, err := patchAsset(a, patchDoc, kind)
+	if err != nil {
+		return nil, err // expected: domain.ErrInvalidPatch, domain.ErrPatchConflict, domain.ErrInvalidPayload
+	}
+	return assetService.applyEdit(ctx, a, newDesc, payload, editorID)
+}
+
+// ListRevisions returns the description edit history of an asset, newest first.
+func (assetService *AssetService) ListRevisions(ctx context.Context, assetID uuid.UUID, limit int, after string) ([]domain.AssetRevision, *string, error) {
+	if _, err := assetService.assetRepo.Get(ctx, assetID); err != nil {
+		return nil, nil, err // expected: domain.ErrAssetNotFound
+	}
+	return assetService.revisionRepo.ListByAssetKeyset(ctx, assetID, limit, after)
+}
+
+// RevertRevision restores the description an asset had before the given revision.
+// The revert is itself recorded as a new revision.
+func (assetService *AssetService) RevertRevision(ctx context.Context, assetID, revisionID uuid.UUID, editorID *uuid.UUID) (*domain.Asset, error) {
 	a, err := assetService.assetRepo.Get(ctx, assetID)
 	if err != nil {
 		return nil, err // expected: domain.ErrAssetNotFound
 	}
+	rev, err := assetService.revisionRepo.Get(ctx, assetID, revisionID)
+	if err != nil {
+		return nil, err // expected: domain.ErrRevisionNotFound
+	}
+	return assetService.applyEdit(ctx, a, rev.OldDescription, nil, editorID)
+}
+
+// applyEdit applies a description (and optionally payload) edit to a loaded asset,
+// saves it and records the revision in the same transaction, together with an asset.description_edited
+// event. Unchanged descriptions are not recorded.
+func (assetService *AssetService) applyEdit(
+	ctx context.Context, a *domain.Asset, newDesc string, payload domain.AssetPayload, editorID *uuid.UUID,
+) (*domain.Asset, error) {
+	oldDesc := a.Description
 	if err := a.EditDescription(newDesc); err != nil {
-		return nil, err // expected: domain.ErrEmptyDescription
+		return nil, err // expected: domain.ErrEmptyDescription, domain.ErrAssetArchived
 	}
-	if err := assetService.assetRepo.Update(ctx, a); err != nil {
+	if payload != nil {
+		if err := a.ReplacePayload(payload); err != nil {
+			return nil, err // expected: domain.ErrInvalidPayload
+		}
+	}
+	err := assetService.uow.WithinTx(ctx, func(ctx context.Context) error {
+		if err := assetService.assetRepo.Update(ctx, a); err != nil {
+			return err
+		}
+		if a.Description == oldDesc {
+			return nil
+		}
+		rev := domain.NewAssetRevision(a.ID, oldDesc, a.Description, editorID)
+		if err := assetService.revisionRepo.Create(ctx, &rev); err != nil {
+			return err
+		}
+		return appendEvents(ctx, assetService.outbox, domain.EventAssetDescriptionEdited, assetEditedEventData{
+			AssetID:        a.ID,
+			OldDescription: oldDesc,
+			NewDescription: a.Description,
+			EditorID:       editorID,
+			Version:        a.Version,
+		})
+	})
+	if err != nil {
 		return nil, err
 	}
 	return a, nil
 }
+
+// Archive soft-deletes an asset: it disappears from listings, favourites are kept.
+// Archiving an archived asset changes nothing.
+func (assetService *AssetService) Archive(ctx context.Context, assetID uuid.UUID) (*domain.Asset, error) {
+	return assetService.changeArchiveState(ctx, assetID, (*domain.Asset).Archive)
+}
+
+// Restore un-archives an asset, making it and its favourites visible again.
+// Restoring an active asset changes nothing.
+func (assetService *AssetService) Restore(ctx context.Context, assetID uuid.UUID) (*domain.Asset, error) {
+	return assetService.changeArchiveState(ctx, assetID, (*domain.Asset).Restore)
+}
+
+// archiveStateAttempts bounds how often an archive/restore is retried when a concurrent edit moves the version on.
+const archiveStateAttempts = 3
+
+// changeArchiveState applies change to the current asset and saves it when it changed anything.
+// Archive and restore take no precondition, so a concurrent edit is retried on the fresh version.
+func (assetService *AssetService) changeArchiveState(
+	ctx context.Context, assetID uuid.UUID, change func(a *domain.Asset) bool,
+) (*domain.Asset, error) {
+	for attempt := 1; ; attempt++ {
+		a, err := assetService.assetRepo.Get(ctx, assetID)
+		if err != nil {
+			return nil, err // expected: domain.ErrAssetNotFound
+		}
+		if !change(a) {
+			return a, nil
+		}
+		err = assetService.assetRepo.Update(ctx, a)
+		if errors.Is(err, domain.ErrAssetVersionMismatch) && attempt < archiveStateAttempts {
+			continue
+		}
+		if err != nil {
+			return nil, err // expected: domain.ErrAssetNotFound, domain.ErrAssetVersionMismatch
+		}
+		return a, nil
+	}
+}
+
+// Delete permanently removes an asset together with its favourites.
+func (assetService *AssetService) Delete(ctx context.Context, assetID uuid.UUID) error {
+	return assetService.assetRepo.Delete(ctx, assetID)
+}
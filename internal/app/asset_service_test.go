@@ -0,0 +1,178 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/ports"
+	"github.com/google/uuid"
+)
+
+func TestListValidatesTheFilter(t *testing.T) {
+	now := time.Now().UTC()
+	earlier := now.Add(-time.Hour)
+
+	tests := []struct {
+		name      string
+		filter    ports.AssetFilter
+		wantErr   error
+		wantQuery string
+	}{
+		{name: "no filter"},
+		{name: "type and range", filter: ports.AssetFilter{Type: domain.AssetTypeChart, CreatedFrom: &earlier, CreatedTo: &now}},
+		{name: "query is trimmed", filter: ports.AssetFilter{Query: "  social media "}, wantQuery: "social media"},
+		{name: "unknown type", filter: ports.AssetFilter{Type: "table"}, wantErr: domain.ErrInvalidAssetType},
+		{name: "empty range", filter: ports.AssetFilter{CreatedFrom: &now, CreatedTo: &now}, wantErr: domain.ErrInvalidTimeRange},
+		{name: "reversed range", filter: ports.AssetFilter{CreatedFrom: &now, CreatedTo: &earlier}, wantErr: domain.ErrInvalidTimeRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &listingRepo{}
+			_, _, err := NewAssetService(inlineUnitOfWork{}, repo, nil, &memOutboxRepo{}).List(context.Background(), tt.filter, 10, "")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("List() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if repo.filter != nil {
+					t.Fatal("List() queried the repository with an invalid filter")
+				}
+				return
+			}
+			if repo.filter == nil || repo.filter.Query != tt.wantQuery {
+				t.Fatalf("List() queried %+v, want query %q", repo.filter, tt.wantQuery)
+			}
+		})
+	}
+}
+
+func TestRevisionHistory(t *testing.T) {
+	ctx := context.Background()
+	id, editor := uuid.New(), uuid.New()
+	assets := &catalogueRepo{assets: map[uuid.UUID]domain.Asset{
+		id: {ID: id, Type: domain.AssetTypeInsight, Description: "First", Payload: domain.InsightPayload{Text: "x"}},
+	}}
+	revisions := &revisionsRepo{}
+	assetService := NewAssetService(inlineUnitOfWork{}, assets, revisions, &memOutboxRepo{})
+
+	if _, err := assetService.EditDescription(ctx, id, "Second", &editor, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := assetService.EditDescription(ctx, id, " Second ", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(revisions.revisions) != 1 {
+		t.Fatalf("recorded %d revisions, want 1: unchanged descriptions are not recorded", len(revisions.revisions))
+	}
+	first := revisions.revisions[0]
+	if first.OldDescription != "First" || first.NewDescription != "Second" || first.EditorID == nil || *first.EditorID != editor {
+		t.Fatalf("revision = %+v", first)
+	}
+
+	a, err := assetService.RevertRevision(ctx, id, first.ID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Description != "First" || len(revisions.revisions) != 2 || revisions.revisions[1].NewDescription != "First" {
+		t.Fatalf("RevertRevision() = %q with revisions %+v, want the revert recorded", a.Description, revisions.revisions)
+	}
+	if _, err := assetService.RevertRevision(ctx, id, uuid.New(), nil); !errors.Is(err, domain.ErrRevisionNotFound) {
+		t.Fatalf("RevertRevision() of an unknown revision error = %v, want %v", err, domain.ErrRevisionNotFound)
+	}
+}
+
+func TestEditDescriptionChecksTheVersion(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+	assets := &catalogueRepo{assets: map[uuid.UUID]domain.Asset{
+		id: {ID: id, Type: domain.AssetTypeInsight, Description: "First", Payload: domain.InsightPayload{Text: "x"}, Version: 1},
+	}}
+	assetService := NewAssetService(inlineUnitOfWork{}, assets, &revisionsRepo{}, &memOutboxRepo{})
+
+	a, err := assetService.EditDescription(ctx, id, "Second", nil, []int{1})
+	if err != nil || a.Version != 2 {
+		t.Fatalf("EditDescription() at the current version = %v, %v, want version 2", a, err)
+	}
+	if _, err := assetService.EditDescription(ctx, id, "Third", nil, []int{1}); !errors.Is(err, domain.ErrAssetVersionMismatch) {
+		t.Fatalf("EditDescription() at a stale version error = %v, want %v", err, domain.ErrAssetVersionMismatch)
+	}
+	if a, err := assetService.EditDescription(ctx, id, "Third", nil, []int{1, 2}); err != nil || a.Description != "Third" {
+		t.Fatalf("EditDescription() matching one of the versions = %v, %v", a, err)
+	}
+
+	// Archive and restore take no precondition; repeating them changes nothing.
+	archived, err := assetService.Archive(ctx, id)
+	if err != nil || archived.Version != 4 {
+		t.Fatalf("Archive() = %v, %v, want version 4", archived, err)
+	}
+	if again, err := assetService.Archive(ctx, id); err != nil || again.Version != 4 {
+		t.Fatalf("Archive() of an archived asset = %v, %v, want version 4", again, err)
+	}
+}
+
+func TestPatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		kind     PatchKind
+		patch    string
+		versions []int
+		wantErr  error
+		wantDesc string
+		wantText string
+		wantRevs int
+	}{
+		{name: "merge patch", kind: MergePatch, patch: `{"payload":{"text":"Patched"}}`, wantDesc: "Insight", wantText: "Patched"},
+		{name: "json patch", kind: JSONPatch, patch: `[{"op":"replace","path":"/description","value":"Renamed"}]`, versions: []int{1}, wantDesc: "Renamed", wantText: "Original", wantRevs: 1},
+		{name: "stale version", kind: MergePatch, patch: `{"description":"Renamed"}`, versions: []int{0}, wantErr: domain.ErrAssetVersionMismatch},
+		{name: "failed test op", kind: JSONPatch, patch: `[{"op":"test","path":"/description","value":"Other"}]`, wantErr: domain.ErrPatchConflict},
+		{name: "malformed patch", kind: MergePatch, patch: `{"description":`, wantErr: domain.ErrInvalidPatch},
+		{name: "unknown field", kind: MergePatch, patch: `{"owner":"me"}`, wantErr: domain.ErrInvalidPatch},
+		{name: "field of another type", kind: MergePatch, patch: `{"payload":{"title":"Chart"}}`, wantErr: domain.ErrInvalidPayload},
+		{name: "empty description", kind: MergePatch, patch: `{"description":""}`, wantErr: domain.ErrEmptyDescription},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := uuid.New()
+			assets := &catalogueRepo{assets: map[uuid.UUID]domain.Asset{
+				id: {ID: id, Type: domain.AssetTypeInsight, Description: "Insight", Payload: domain.InsightPayload{Text: "Original"}, Version: 1},
+			}}
+			revisions := &revisionsRepo{}
+			assetService := NewAssetService(inlineUnitOfWork{}, assets, revisions, &memOutboxRepo{})
+
+			a, err := assetService.Patch(context.Background(), id, []byte(tt.patch), tt.kind, nil, tt.versions)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Patch() error = %v, want %v", err, tt.wantErr)
+				}
+				if stored := assets.assets[id]; stored.Version != 1 {
+					t.Fatalf("Patch() saved version %d after failing", stored.Version)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if a.Description != tt.wantDesc || a.Payload != (domain.InsightPayload{Text: tt.wantText}) || a.Version != 2 {
+				t.Fatalf("Patch() = %q %v v%d, want %q %q v2", a.Description, a.Payload, a.Version, tt.wantDesc, tt.wantText)
+			}
+			if len(revisions.revisions) != tt.wantRevs {
+				t.Fatalf("Patch() recorded %d revisions, want %d", len(revisions.revisions), tt.wantRevs)
+			}
+		})
+	}
+}
+
+// listingRepo is a ports.AssetRepository recording the filter it was listed with.
+type listingRepo struct {
+	ports.AssetRepository
+	filter *ports.AssetFilter
+}
+
+func (repo *listingRepo) ListKeyset(_ context.Context, filter ports.AssetFilter, _ int, _ string) ([]domain.Asset, *string, error) {
+	repo.filter = &filter
+	return nil, nil, nil
+}
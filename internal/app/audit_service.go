@@ -0,0 +1,31 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/ports"
+)
+
+// AuditService reads the audit log of asset and favourite changes.
+type AuditService struct {
+	auditRepo ports.AuditRepository
+}
+
+func NewAuditService(auditRepo ports.AuditRepository) *AuditService {
+	return &AuditService{auditRepo: auditRepo}
+}
+
+// List returns the entries matching filter, newest first.
+func (auditService *AuditService) List(
+	ctx context.Context, filter domain.AuditFilter, limit int, after string,
+) ([]domain.AuditEntry, *string, error) {
+	if filter.Action != "" && !filter.Action.Valid() {
+		return nil, nil, fmt.Errorf("%w: unknown action %q", domain.ErrInvalidAuditFilter, filter.Action)
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return nil, nil, domain.ErrInvalidTimeRange
+	}
+	return auditService.auditRepo.ListKeyset(ctx, filter, limit, after) // expected: domain.ErrBadCursor
+}
@@ -0,0 +1,123 @@
+package app
+
+import (
+	"context"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/ports"
+	"github.com/google/uuid"
+)
+
+// CollectionService manages a user's collections and which favourites they hold.
+type CollectionService struct {
+	userRepo       ports.UserRepository
+	favRepo        ports.FavouriteRepository
+	collectionRepo ports.CollectionRepository
+}
+
+func NewCollectionService(
+	userRepo ports.UserRepository,
+	favRepo ports.FavouriteRepository,
+	collectionRepo ports.CollectionRepository,
+) *CollectionService {
+	return &CollectionService{
+		userRepo:       userRepo,
+		favRepo:        favRepo,
+		collectionRepo: collectionRepo,
+	}
+}
+
+// Create validates the user and the name, then creates a collection.
+func (collectionService *CollectionService) Create(ctx context.Context, userID uuid.UUID, name string) (domain.Collection, error) {
+	if err := collectionService.ensureUser(ctx, userID); err != nil {
+		return domain.Collection{}, err
+	}
+
+	c, err := domain.NewCollection(userID, name)
+	if err != nil {
+		return domain.Collection{}, err // expected: domain.ErrInvalidCollectionName
+	}
+	if err := collectionService.collectionRepo.Create(ctx, &c); err != nil {
+		return domain.Collection{}, err // expected: domain.ErrCollectionAlreadyExists
+	}
+	return c, nil
+}
+
+// List returns all collections of a user.
+func (collectionService *CollectionService) List(ctx context.Context, userID uuid.UUID) ([]domain.Collection, error) {
+	if err := collectionService.ensureUser(ctx, userID); err != nil {
+		return nil, err
+	}
+	return collectionService.collectionRepo.ListByUser(ctx, userID)
+}
+
+// Get returns one of the user's collections or domain.ErrCollectionNotFound.
+func (collectionService *CollectionService) Get(ctx context.Context, userID, collectionID uuid.UUID) (*domain.Collection, error) {
+	return collectionService.collectionRepo.Get(ctx, userID, collectionID)
+}
+
+// Rename changes the name of one of the user's collections.
+func (collectionService *CollectionService) Rename(ctx context.Context, userID, collectionID uuid.UUID, name string) (*domain.Collection, error) {
+	c, err := collectionService.collectionRepo.Get(ctx, userID, collectionID)
+	if err != nil {
+		return nil, err // expected: domain.ErrCollectionNotFound
+	}
+	if err := c.Rename(name); err != nil {
+		return nil, err // expected: domain.ErrInvalidCollectionName
+	}
+	if err := collectionService.collectionRepo.Update(ctx, c); err != nil {
+		return nil, err // expected: domain.ErrCollectionAlreadyExists
+	}
+	return c, nil
+}
+
+// Delete removes one of the user's collections. The favourites themselves are kept.
+func (collectionService *CollectionService) Delete(ctx context.Context, userID, collectionID uuid.UUID) error {
+	return collectionService.collectionRepo.Delete(ctx, userID, collectionID)
+}
+
+// AddFavourite puts the user's favourite of assetID into a collection.
+// The asset must already be favourited (domain.ErrFavouriteNotFound otherwise).
+func (collectionService *CollectionService) AddFavourite(ctx context.Context, userID, collectionID, assetID uuid.UUID) error {
+	c, f, err := collectionService.loadMembership(ctx, userID, collectionID, assetID)
+	if err != nil {
+		return err
+	}
+	return collectionService.collectionRepo.AddFavourite(ctx, c.ID, f.ID)
+}
+
+// RemoveFavourite takes the user's favourite of assetID out of a collection.
+func (collectionService *CollectionService) RemoveFavourite(ctx context.Context, userID, collectionID, assetID uuid.UUID) error {
+	c, f, err := collectionService.loadMembership(ctx, userID, collectionID, assetID)
+	if err != nil {
+		return err
+	}
+	return collectionService.collectionRepo.RemoveFavourite(ctx, c.ID, f.ID)
+}
+
+// loadMembership loads a collection and a favourite, both owned by userID.
+func (collectionService *CollectionService) loadMembership(
+	ctx context.Context, userID, collectionID, assetID uuid.UUID,
+) (*domain.Collection, *domain.Favourite, error) {
+	c, err := collectionService.collectionRepo.Get(ctx, userID, collectionID)
+	if err != nil {
+		return nil, nil, err // expected: domain.ErrCollectionNotFound
+	}
+	f, err := collectionService.favRepo.Get(ctx, userID, assetID)
+	if err != nil {
+		return nil, nil, err // expected: domain.ErrFavouriteNotFound
+	}
+	return c, f, nil
+}
+
+// ensureUser returns domain.ErrUserNotFound if the user does not exist.
+func (collectionService *CollectionService) ensureUser(ctx context.Context, userID uuid.UUID) error {
+	ok, err := collectionService.userRepo.Exists(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return domain.ErrUserNotFound
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/ports"
+	"github.com/google/uuid"
+)
+
+// favouriteEventData is the payload of favourite events. The favourite fields are set for
+// additions and note edits. The note itself is private and never leaves through events.
+type favouriteEventData struct {
+	UserID       uuid.UUID  `json:"user_id"`
+	AssetID      uuid.UUID  `json:"asset_id"`
+	FavouriteID  *uuid.UUID `json:"favourite_id,omitempty"`
+	FavouritedAt *time.Time `json:"favourited_at,omitempty"`
+}
+
+func favouriteData(f domain.Favourite) favouriteEventData {
+	return favouriteEventData{UserID: f.UserID, AssetID: f.AssetID, FavouriteID: &f.ID, FavouritedAt: &f.CreatedAt}
+}
+
+func favouriteRemovedData(userID, assetID uuid.UUID) favouriteEventData {
+	return favouriteEventData{UserID: userID, AssetID: assetID}
+}
+
+// assetEditedEventData is the payload of asset.description_edited events.
+type assetEditedEventData struct {
+	AssetID        uuid.UUID  `json:"asset_id"`
+	OldDescription string     `json:"old_description"`
+	NewDescription string     `json:"new_description"`
+	EditorID       *uuid.UUID `json:"editor_id,omitempty"`
+	Version        int        `json:"version"`
+}
+
+// appendEvents records one outbox event per data item. Use-cases call it inside their transaction
+// so that the events are committed exactly when the change is.
+func appendEvents(ctx context.Context, outbox ports.OutboxRepository, eventType domain.EventType, data ...any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	events := make([]domain.OutboxEvent, 0, len(data))
+	for _, item := range data {
+		payload, err := json.Marshal(item)
+		if err != nil {
+			return err
+		}
+		events = append(events, domain.NewOutboxEvent(eventType, payload))
+	}
+	return outbox.Append(ctx, events)
+}
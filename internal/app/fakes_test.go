@@ -0,0 +1,137 @@
+package app
+
+import (
+	"context"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/ports"
+	"github.com/google/uuid"
+)
+
+// Fakes shared by the service tests. Each embeds its port, so calling a method the fake doesn't
+// implement panics instead of passing silently.
+
+// catalogueRepo is a ports.AssetRepository keeping assets in a map; Update enforces the version like the real one.
+type catalogueRepo struct {
+	ports.AssetRepository
+	assets map[uuid.UUID]domain.Asset
+}
+
+func (repo *catalogueRepo) Get(_ context.Context, id uuid.UUID) (*domain.Asset, error) {
+	a, ok := repo.assets[id]
+	if !ok {
+		return nil, domain.ErrAssetNotFound
+	}
+	return &a, nil
+}
+
+func (repo *catalogueRepo) GetForShare(ctx context.Context, id uuid.UUID) (*domain.Asset, error) {
+	return repo.Get(ctx, id)
+}
+
+func (repo *catalogueRepo) GetMany(_ context.Context, ids []uuid.UUID) ([]domain.Asset, error) {
+	var out []domain.Asset
+	for _, id := range ids {
+		if a, ok := repo.assets[id]; ok {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+func (repo *catalogueRepo) GetManyForShare(ctx context.Context, ids []uuid.UUID) ([]domain.Asset, error) {
+	return repo.GetMany(ctx, ids)
+}
+
+func (repo *catalogueRepo) Update(_ context.Context, a *domain.Asset) error {
+	stored, ok := repo.assets[a.ID]
+	if !ok {
+		return domain.ErrAssetNotFound
+	}
+	if stored.Version != a.Version {
+		return domain.ErrAssetVersionMismatch
+	}
+	a.Version++
+	repo.assets[a.ID] = *a
+	return nil
+}
+
+// revisionsRepo is a ports.AssetRevisionRepository recording the created revisions.
+type revisionsRepo struct {
+	ports.AssetRevisionRepository
+	revisions []domain.AssetRevision
+}
+
+func (repo *revisionsRepo) Create(_ context.Context, rev *domain.AssetRevision) error {
+	rev.ID = uuid.New()
+	repo.revisions = append(repo.revisions, *rev)
+	return nil
+}
+
+func (repo *revisionsRepo) Get(_ context.Context, assetID, revisionID uuid.UUID) (*domain.AssetRevision, error) {
+	for _, rev := range repo.revisions {
+		if rev.AssetID == assetID && rev.ID == revisionID {
+			return &rev, nil
+		}
+	}
+	return nil, domain.ErrRevisionNotFound
+}
+
+// usersRepo is a ports.UserRepository that knows only the listed users.
+type usersRepo struct {
+	ports.UserRepository
+	known []uuid.UUID
+}
+
+func (repo usersRepo) Exists(_ context.Context, id uuid.UUID) (bool, error) {
+	for _, known := range repo.known {
+		if known == id {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// inlineUnitOfWork runs use-cases without a transaction.
+type inlineUnitOfWork struct{}
+
+func (inlineUnitOfWork) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// favouritesRepo is a ports.FavouriteRepository keeping favourites in a map by (user, asset).
+type favouritesRepo struct {
+	ports.FavouriteRepository
+	favs map[[2]uuid.UUID]domain.Favourite
+}
+
+func (repo *favouritesRepo) Create(_ context.Context, f *domain.Favourite) error {
+	key := [2]uuid.UUID{f.UserID, f.AssetID}
+	if _, ok := repo.favs[key]; ok {
+		return domain.ErrFavouriteAlreadyExists
+	}
+	if repo.favs == nil {
+		repo.favs = make(map[[2]uuid.UUID]domain.Favourite)
+	}
+	f.ID = uuid.New()
+	repo.favs[key] = *f
+	return nil
+}
+
+func (repo *favouritesRepo) Get(_ context.Context, userID, assetID uuid.UUID) (*domain.Favourite, error) {
+	f, ok := repo.favs[[2]uuid.UUID{userID, assetID}]
+	if !ok {
+		return nil, domain.ErrFavouriteNotFound
+	}
+	return &f, nil
+}
+
+func (repo *favouritesRepo) GetMany(_ context.Context, userID uuid.UUID, assetIDs []uuid.UUID) ([]domain.Favourite, error) {
+	var out []domain.Favourite
+	for _, assetID := range assetIDs {
+		if f, ok := repo.favs[[2]uuid.UUID{userID, assetID}]; ok {
+			out = append(out, f)
+		}
+	}
+	return out, nil
+}
@@ -2,79 +2,517 @@ package app
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
 	"github.com/SokratisChaimanas/platform-go-challenge/internal/ports"
 	"github.com/google/uuid"
 )
 
+// exportPageSize is how many favourites Export reads per query (the repository maximum).
+const exportPageSize = 50
+
 // FavouritesService coordinates multiple repositories and applies business rules.
 type FavouritesService struct {
-	userRepo  ports.UserRepository
-	assetRepo ports.AssetRepository
-	favRepo   ports.FavouriteRepository
+	uow            ports.UnitOfWork
+	userRepo       ports.UserRepository
+	assetRepo      ports.AssetRepository
+	favRepo        ports.FavouriteRepository
+	collectionRepo ports.CollectionRepository
+	outbox         ports.OutboxRepository
+	events         ports.EventBroker // live event subscriptions; fed by the outbox relay
 }
 
 func NewFavouritesService(
+	uow ports.UnitOfWork,
 	userRepo ports.UserRepository,
 	assetRepo ports.AssetRepository,
 	favRepo ports.FavouriteRepository,
+	collectionRepo ports.CollectionRepository,
+	outbox ports.OutboxRepository,
+	events ports.EventBroker,
 ) *FavouritesService {
 	return &FavouritesService{
-		userRepo:  userRepo,
-		assetRepo: assetRepo,
-		favRepo:   favRepo,
+		uow:            uow,
+		userRepo:       userRepo,
+		assetRepo:      assetRepo,
+		favRepo:        favRepo,
+		collectionRepo: collectionRepo,
+		outbox:         outbox,
+		events:         events,
 	}
 }
 
-// Add validates user and asset existence, prevents duplicates, then creates a favourite.
-func (favService *FavouritesService) Add(ctx context.Context, userID, assetID uuid.UUID) (domain.Favourite, error) {
-	// Ensure user exists.
-	ok, err := favService.userRepo.Exists(ctx, userID)
+// Add validates user and asset existence, rejects archived assets, then creates a favourite.
+// Duplicates are rejected by the repository's unique constraint, which also covers concurrent adds.
+func (favService *FavouritesService) Add(ctx context.Context, userID, assetID uuid.UUID, note string) (domain.Favourite, error) {
+	favToReturn, err := domain.NewFavourite(userID, assetID, note)
+	if err != nil {
+		return domain.Favourite{}, err // expected: domain.ErrNoteTooLong
+	}
+	err = favService.uow.WithinTx(ctx, func(ctx context.Context) error {
+		if err := favService.ensureFavouritable(ctx, userID, assetID); err != nil {
+			return err
+		}
+		if err := favService.favRepo.Create(ctx, &favToReturn); err != nil {
+			return err // expected: domain.ErrFavouriteAlreadyExists, domain.ErrAssetNotFound
+		}
+		return appendEvents(ctx, favService.outbox, domain.EventFavouriteAdded, favouriteData(favToReturn))
+	})
 	if err != nil {
 		return domain.Favourite{}, err
 	}
-	if !ok {
-		return domain.Favourite{}, domain.ErrUserNotFound
+	return favToReturn, nil
+}
+
+// Put idempotently favourites an asset: it creates the favourite, or returns the existing one
+// with created=false, so repeated or concurrent calls all succeed with the same favourite. An existing
+// favourite is returned even when its asset has been archived since.
+func (favService *FavouritesService) Put(ctx context.Context, userID, assetID uuid.UUID) (domain.Favourite, bool, error) {
+	favToReturn, err := domain.NewFavourite(userID, assetID, "")
+	if err != nil {
+		return domain.Favourite{}, false, err
 	}
+	created := false
+	err = favService.uow.WithinTx(ctx, func(ctx context.Context) error {
+		if err := favService.ensureUser(ctx, userID); err != nil {
+			return err
+		}
+		existing, err := favService.favRepo.Get(ctx, userID, assetID)
+		if err == nil {
+			favToReturn = *existing
+			return nil
+		}
+		if !errors.Is(err, domain.ErrFavouriteNotFound) {
+			return err
+		}
 
-	// Ensure asset exists.
-	if _, err := favService.assetRepo.Get(ctx, assetID); err != nil {
-		return domain.Favourite{}, err // expected: domain.ErrAssetNotFound
+		if err := favService.ensureFavouritable(ctx, userID, assetID); err != nil {
+			return err
+		}
+		if err := favService.favRepo.Create(ctx, &favToReturn); err != nil {
+			return err
+		}
+		created = true
+		return appendEvents(ctx, favService.outbox, domain.EventFavouriteAdded, favouriteData(favToReturn))
+	})
+	if err == nil {
+		return favToReturn, created, nil
+	}
+	if !errors.Is(err, domain.ErrFavouriteAlreadyExists) {
+		return domain.Favourite{}, false, err
 	}
 
-	// Prevent duplicates.
-	exists, err := favService.favRepo.Exists(ctx, userID, assetID)
+	// Added concurrently. The failed insert aborted its transaction, so the favourite is read outside it.
+	existing, err := favService.favRepo.Get(ctx, userID, assetID)
 	if err != nil {
+		return domain.Favourite{}, false, err
+	}
+	return *existing, false, nil
+}
+
+// ensureFavouritable checks that the user exists and the asset exists and is not archived.
+// The asset stays locked until the transaction ends, so it can't be archived before the favourite commits.
+func (favService *FavouritesService) ensureFavouritable(ctx context.Context, userID, assetID uuid.UUID) error {
+	if err := favService.ensureUser(ctx, userID); err != nil {
+		return err
+	}
+
+	a, err := favService.assetRepo.GetForShare(ctx, assetID)
+	if err != nil {
+		return err // expected: domain.ErrAssetNotFound
+	}
+	if a.IsArchived() {
+		return domain.ErrAssetArchived
+	}
+	return nil
+}
+
+// ensureUser returns domain.ErrUserNotFound unless the user exists.
+func (favService *FavouritesService) ensureUser(ctx context.Context, userID uuid.UUID) error {
+	ok, err := favService.userRepo.Exists(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return domain.ErrUserNotFound
+	}
+	return nil
+}
+
+// Get returns the user's favourite of an asset. Like the favourites list, it hides favourites of
+// archived assets: a missing or hidden pair should return domain.ErrFavouriteNotFound.
+func (favService *FavouritesService) Get(ctx context.Context, userID, assetID uuid.UUID) (domain.Favourite, error) {
+	if err := favService.ensureUser(ctx, userID); err != nil {
 		return domain.Favourite{}, err
 	}
-	if exists {
-		return domain.Favourite{}, domain.ErrFavouriteAlreadyExists
+	f, err := favService.favRepo.Get(ctx, userID, assetID)
+	if err != nil {
+		return domain.Favourite{}, err // expected: domain.ErrFavouriteNotFound
 	}
+	listed, err := favService.listedAssets(ctx, []uuid.UUID{assetID})
+	if err != nil {
+		return domain.Favourite{}, err
+	}
+	if !listed[assetID] {
+		return domain.Favourite{}, domain.ErrFavouriteNotFound
+	}
+	return *f, nil
+}
+
+// listedAssets returns which of assetIDs exist and are not archived, i.e. whose favourites are listed.
+func (favService *FavouritesService) listedAssets(ctx context.Context, assetIDs []uuid.UUID) (map[uuid.UUID]bool, error) {
+	assets, err := favService.assetRepo.GetMany(ctx, assetIDs)
+	if err != nil {
+		return nil, err
+	}
+	listed := make(map[uuid.UUID]bool, len(assets))
+	for _, a := range assets {
+		listed[a.ID] = !a.IsArchived()
+	}
+	return listed, nil
+}
+
+// Status reports, for up to domain.MaxBatchSize assets, the user's favourite of each asset (nil when not
+// favourited or, as in the favourites list, when the asset is archived).
+func (favService *FavouritesService) Status(ctx context.Context, userID uuid.UUID, assetIDs []uuid.UUID) (map[uuid.UUID]*domain.Favourite, error) {
+	assetIDs, err := domain.ValidateBatch(assetIDs)
+	if err != nil {
+		return nil, err // expected: domain.ErrEmptyBatch, domain.ErrBatchTooLarge
+	}
+	if err := favService.ensureUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	favs, err := favService.favRepo.GetMany(ctx, userID, assetIDs)
+	if err != nil {
+		return nil, err
+	}
+	listed, err := favService.listedAssets(ctx, assetIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	status := make(map[uuid.UUID]*domain.Favourite, len(assetIDs))
+	for _, assetID := range assetIDs {
+		status[assetID] = nil
+	}
+	for i := range favs {
+		if listed[favs[i].AssetID] {
+			status[favs[i].AssetID] = &favs[i]
+		}
+	}
+	return status, nil
+}
 
-	// Create favourite.
-	favToReturn := domain.NewFavourite(userID, assetID)
-	if err := favService.favRepo.Create(ctx, &favToReturn); err != nil {
+// EditNote changes the note of an existing favourite. Missing pair should return domain.ErrFavouriteNotFound.
+func (favService *FavouritesService) EditNote(ctx context.Context, userID, assetID uuid.UUID, note string) (domain.Favourite, error) {
+	var f *domain.Favourite
+	err := favService.uow.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		f, err = favService.favRepo.Get(ctx, userID, assetID)
+		if err != nil {
+			return err // expected: domain.ErrFavouriteNotFound
+		}
+		if err := f.EditNote(note); err != nil {
+			return err // expected: domain.ErrNoteTooLong
+		}
+		if err := favService.favRepo.Update(ctx, f); err != nil {
+			return err
+		}
+		return appendEvents(ctx, favService.outbox, domain.EventFavouriteNoteEdited, favouriteData(*f))
+	})
+	if err != nil {
 		return domain.Favourite{}, err
 	}
-	return favToReturn, nil
+	return *f, nil
 }
 
 // Remove deletes a favourite. Missing pair should return domain.ErrFavouriteNotFound.
 func (favService *FavouritesService) Remove(ctx context.Context, userID, assetID uuid.UUID) error {
-	return favService.favRepo.Delete(ctx, userID, assetID)
+	return favService.uow.WithinTx(ctx, func(ctx context.Context) error {
+		if err := favService.favRepo.Delete(ctx, userID, assetID); err != nil {
+			return err
+		}
+		return appendEvents(ctx, favService.outbox, domain.EventFavouriteRemoved, favouriteRemovedData(userID, assetID))
+	})
+}
+
+// AddMany favourites up to domain.MaxBatchSize assets in one transaction. Duplicate IDs are collapsed and
+// each asset gets its own outcome (created, already_exists, asset_not_found, asset_archived)
+// rather than the whole batch failing.
+func (favService *FavouritesService) AddMany(ctx context.Context, userID uuid.UUID, assetIDs []uuid.UUID) ([]domain.FavouriteResult, error) {
+	assetIDs, err := domain.ValidateBatch(assetIDs)
+	if err != nil {
+		return nil, err // expected: domain.ErrEmptyBatch, domain.ErrBatchTooLarge
+	}
+
+	var results []domain.FavouriteResult
+	err = favService.uow.WithinTx(ctx, func(ctx context.Context) error {
+		if err := favService.ensureUser(ctx, userID); err != nil {
+			return err
+		}
+
+		assets, err := favService.assetRepo.GetManyForShare(ctx, assetIDs)
+		if err != nil {
+			return err
+		}
+		archived := make(map[uuid.UUID]bool, len(assets))
+		for _, a := range assets {
+			archived[a.ID] = a.IsArchived()
+		}
+		existing, err := favService.favouritedAssets(ctx, userID, assetIDs)
+		if err != nil {
+			return err
+		}
+
+		results = make([]domain.FavouriteResult, 0, len(assetIDs))
+		toCreate := make([]domain.Favourite, 0, len(assetIDs))
+		createdAt := make([]int, 0, len(assetIDs)) // index in results of each favourite to create
+		for _, assetID := range assetIDs {
+			isArchived, found := archived[assetID]
+			outcome := domain.FavouriteCreated
+			switch {
+			case !found:
+				outcome = domain.FavouriteAssetNotFound
+			case existing[assetID]:
+				outcome = domain.FavouriteAlreadyExists
+			case isArchived:
+				outcome = domain.FavouriteAssetArchived
+			default:
+				f, err := domain.NewFavourite(userID, assetID, "")
+				if err != nil {
+					return err
+				}
+				toCreate = append(toCreate, f)
+				createdAt = append(createdAt, len(results))
+			}
+			results = append(results, domain.FavouriteResult{AssetID: assetID, Outcome: outcome})
+		}
+
+		// A pair added concurrently (or an asset deleted meanwhile) since the checks above
+		// only changes the outcome of its own row.
+		outcomes, err := favService.favRepo.CreateMany(ctx, toCreate)
+		if err != nil {
+			return err
+		}
+
+		data := make([]any, 0, len(toCreate))
+		for i, f := range toCreate {
+			results[createdAt[i]].Outcome = outcomes[i]
+			if outcomes[i] == domain.FavouriteCreated {
+				data = append(data, favouriteData(f))
+			}
+		}
+		return appendEvents(ctx, favService.outbox, domain.EventFavouriteAdded, data...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
 }
 
-// ListByUserKeyset returns a user's favourited assets using a keyset cursor.
-func (favService *FavouritesService) ListByUserKeyset(ctx context.Context, userID uuid.UUID, limit int, after string) ([]domain.Asset, *string, error) {
+// RemoveMany deletes up to domain.MaxBatchSize favourites in one transaction, reporting a per-asset
+// outcome (removed, not_found).
+func (favService *FavouritesService) RemoveMany(ctx context.Context, userID uuid.UUID, assetIDs []uuid.UUID) ([]domain.FavouriteResult, error) {
+	assetIDs, err := domain.ValidateBatch(assetIDs)
+	if err != nil {
+		return nil, err // expected: domain.ErrEmptyBatch, domain.ErrBatchTooLarge
+	}
+
+	var results []domain.FavouriteResult
+	err = favService.uow.WithinTx(ctx, func(ctx context.Context) error {
+		if err := favService.ensureUser(ctx, userID); err != nil {
+			return err
+		}
+
+		existing, err := favService.favouritedAssets(ctx, userID, assetIDs)
+		if err != nil {
+			return err
+		}
+
+		results = make([]domain.FavouriteResult, 0, len(assetIDs))
+		var removed []any
+		for _, assetID := range assetIDs {
+			outcome := domain.FavouriteNotFound
+			if existing[assetID] {
+				outcome = domain.FavouriteRemoved
+				removed = append(removed, favouriteRemovedData(userID, assetID))
+			}
+			results = append(results, domain.FavouriteResult{AssetID: assetID, Outcome: outcome})
+		}
+
+		if err := favService.favRepo.DeleteMany(ctx, userID, assetIDs); err != nil {
+			return err
+		}
+		return appendEvents(ctx, favService.outbox, domain.EventFavouriteRemoved, removed...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// Subscribe follows the user's live favourite events, replaying the retained ones after lastEventID (0 for none).
+func (favService *FavouritesService) Subscribe(ctx context.Context, userID uuid.UUID, lastEventID uint64) (ports.EventSubscription, error) {
+	if err := favService.ensureUser(ctx, userID); err != nil {
+		return ports.EventSubscription{}, err
+	}
+	return favService.events.Subscribe(userID, lastEventID) // expected: domain.ErrEventsClosed
+}
+
+// favouritedAssets returns which of assetIDs the user has already favourited.
+func (favService *FavouritesService) favouritedAssets(ctx context.Context, userID uuid.UUID, assetIDs []uuid.UUID) (map[uuid.UUID]bool, error) {
+	favs, err := favService.favRepo.GetMany(ctx, userID, assetIDs)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[uuid.UUID]bool, len(favs))
+	for _, f := range favs {
+		out[f.AssetID] = true
+	}
+	return out, nil
+}
+
+// ListByUserKeyset returns a user's favourited assets (with their favourite) using keyset cursors,
+// paging forward from after or backward from before. A collection filter must name one of the user's collections.
+func (favService *FavouritesService) ListByUserKeyset(
+	ctx context.Context, userID uuid.UUID, filter ports.FavouriteFilter, limit int, after, before string,
+) ([]domain.FavouritedAsset, ports.PageCursors, error) {
+	if after != "" && before != "" {
+		return nil, ports.PageCursors{}, fmt.Errorf("%w: after and before are mutually exclusive", domain.ErrBadCursor)
+	}
+
 	// Validate the user exists (same as ListByUser does).
 	ok, err := favService.userRepo.Exists(ctx, userID)
 	if err != nil {
-		return nil, nil, err
+		return nil, ports.PageCursors{}, err
 	}
 	if !ok {
-		return nil, nil, domain.ErrUserNotFound
+		return nil, ports.PageCursors{}, domain.ErrUserNotFound
+	}
+	if filter.AssetType != "" && !filter.AssetType.Valid() {
+		return nil, ports.PageCursors{}, domain.ErrInvalidAssetType
+	}
+	if filter.Sort == "" {
+		filter.Sort = domain.SortCreatedAsc
+	}
+	if !filter.Sort.Valid() {
+		return nil, ports.PageCursors{}, domain.ErrInvalidSort
+	}
+	if filter.CollectionID != nil {
+		if _, err := favService.collectionRepo.Get(ctx, userID, *filter.CollectionID); err != nil {
+			return nil, ports.PageCursors{}, err // expected: domain.ErrCollectionNotFound
+		}
 	}
 	// Delegate to repository (repo clamps limit to defaults/caps just like your offset path).
-	return favService.favRepo.ListAssetsFavouritedByUserKeyset(ctx, userID, limit, after)
+	return favService.favRepo.ListAssetsFavouritedByUserKeyset(ctx, userID, filter, limit, after, before)
+}
+
+// CountByUser returns how many favourites match filter across all pages.
+// It expects the user and filter already validated by ListByUserKeyset.
+func (favService *FavouritesService) CountByUser(ctx context.Context, userID uuid.UUID, filter ports.FavouriteFilter) (int, error) {
+	return favService.favRepo.CountAssetsFavouritedByUser(ctx, userID, filter)
+}
+
+// Popular ranks non-archived assets by all-time favourite count.
+func (favService *FavouritesService) Popular(ctx context.Context, limit, offset int) ([]domain.PopularAsset, bool, error) {
+	return favService.favRepo.ListMostFavourited(ctx, nil, limit, offset)
+}
+
+// Trending ranks non-archived assets by the favourites they received within the last window.
+func (favService *FavouritesService) Trending(ctx context.Context, window time.Duration, limit, offset int) ([]domain.PopularAsset, bool, error) {
+	since, err := domain.TrendingSince(time.Now().UTC(), window)
+	if err != nil {
+		return nil, false, err // expected: domain.ErrInvalidWindow
+	}
+	return favService.favRepo.ListMostFavourited(ctx, &since, limit, offset)
+}
+
+// Export streams all of the user's (non-archived) favourites with their assets to fn, page by page,
+// in favourite creation order. It stops at the first error fn returns.
+func (favService *FavouritesService) Export(ctx context.Context, userID uuid.UUID, fn func(domain.FavouritedAsset) error) error {
+	if err := favService.ensureUser(ctx, userID); err != nil {
+		return err
+	}
+
+	filter := ports.FavouriteFilter{Sort: domain.SortCreatedAsc}
+	after := ""
+	for {
+		items, cursors, err := favService.favRepo.ListAssetsFavouritedByUserKeyset(ctx, userID, filter, exportPageSize, after, "")
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			if err := fn(item); err != nil {
+				return err
+			}
+		}
+		if cursors.NextAfter == nil {
+			return nil
+		}
+		after = *cursors.NextAfter
+	}
+}
+
+// Import favourites each row through Add, so rows get the same validation, and reports a per-row
+// outcome (created, already_exists, asset_not_found, asset_archived, invalid, failed) instead of failing.
+// Only a cancelled ctx stops the import.
+func (favService *FavouritesService) Import(ctx context.Context, userID uuid.UUID, rows []domain.ImportRow) ([]domain.ImportResult, error) {
+	if len(rows) > domain.MaxImportRows {
+		return nil, domain.ErrTooManyImportRows
+	}
+	if err := favService.ensureUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	results := make([]domain.ImportResult, 0, len(rows))
+	for i, row := range rows {
+		result := domain.ImportResult{Row: i + 1, AssetID: row.AssetID, Outcome: domain.FavouriteCreated}
+
+		assetID, err := uuid.Parse(strings.TrimSpace(row.AssetID))
+		if err != nil {
+			result.Outcome, result.Reason = domain.FavouriteInvalid, "invalid asset_id"
+			results = append(results, result)
+			continue
+		}
+
+		_, err = favService.Add(ctx, userID, assetID, row.Note)
+		switch {
+		case err == nil:
+		case errors.Is(err, domain.ErrFavouriteAlreadyExists):
+			result.Outcome = domain.FavouriteAlreadyExists
+		case errors.Is(err, domain.ErrAssetNotFound):
+			result.Outcome = domain.FavouriteAssetNotFound
+		case errors.Is(err, domain.ErrAssetArchived):
+			result.Outcome = domain.FavouriteAssetArchived
+		case errors.Is(err, domain.ErrNoteTooLong):
+			result.Outcome, result.Reason = domain.FavouriteInvalid, "note is too long"
+		case ctx.Err() != nil:
+			return nil, ctx.Err()
+		default:
+			// Each row has its own transaction, so the rows before and after are unaffected.
+			result.Outcome, result.Reason = domain.FavouriteFailed, "could not be added, retry later"
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// Changes returns the user's favourite additions and removals since token ("" for everything),
+// with the token to pass next time and whether more changes can be fetched right away.
+func (favService *FavouritesService) Changes(ctx context.Context, userID uuid.UUID, token string, limit int) ([]domain.FavouriteChange, string, bool, error) {
+	if err := favService.ensureUser(ctx, userID); err != nil {
+		return nil, "", false, err
+	}
+	return favService.favRepo.ListChanges(ctx, userID, token, limit) // expected: domain.ErrSyncTokenExpired, domain.ErrBadCursor
+}
+
+// PruneTombstones forgets favourite removals older than retention; sync tokens outlive it only
+// as expired tokens.
+func (favService *FavouritesService) PruneTombstones(ctx context.Context, retention time.Duration) (int, error) {
+	return favService.favRepo.PruneTombstones(ctx, time.Now().UTC().Add(-retention))
 }
@@ -0,0 +1,282 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/ports"
+	"github.com/google/uuid"
+)
+
+func TestListByUserKeysetValidatesTheFilter(t *testing.T) {
+	user := uuid.New()
+
+	tests := []struct {
+		name     string
+		userID   uuid.UUID
+		filter   ports.FavouriteFilter
+		after    string
+		before   string
+		wantErr  error
+		wantSort domain.FavouriteSort
+	}{
+		{name: "defaults to oldest first", userID: user, wantSort: domain.SortCreatedAsc},
+		{name: "type and sort", userID: user, filter: ports.FavouriteFilter{AssetType: domain.AssetTypeChart, Sort: domain.SortDescription}, wantSort: domain.SortDescription},
+		{name: "unknown user", userID: uuid.New(), wantErr: domain.ErrUserNotFound},
+		{name: "unknown type", userID: user, filter: ports.FavouriteFilter{AssetType: "table"}, wantErr: domain.ErrInvalidAssetType},
+		{name: "unknown sort", userID: user, filter: ports.FavouriteFilter{Sort: "popular"}, wantErr: domain.ErrInvalidSort},
+		{name: "paging backward", userID: user, before: "cursor", wantSort: domain.SortCreatedAsc},
+		{name: "after and before", userID: user, after: "cursor", before: "cursor", wantErr: domain.ErrBadCursor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			favs := &listedFavouritesRepo{}
+			favService := NewFavouritesService(inlineUnitOfWork{}, usersRepo{known: []uuid.UUID{user}}, nil, favs, nil, &memOutboxRepo{}, nil)
+
+			_, _, err := favService.ListByUserKeyset(context.Background(), tt.userID, tt.filter, 10, tt.after, tt.before)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ListByUserKeyset() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if favs.filter != nil {
+					t.Fatal("ListByUserKeyset() queried the repository with an invalid filter")
+				}
+				return
+			}
+			if favs.filter == nil || favs.filter.Sort != tt.wantSort {
+				t.Fatalf("ListByUserKeyset() queried %+v, want sort %q", favs.filter, tt.wantSort)
+			}
+		})
+	}
+}
+
+// favouritesFixture is a user with one favourited asset, one archived asset and one plain asset.
+type favouritesFixture struct {
+	favService                 *FavouritesService
+	assets                     *catalogueRepo
+	outbox                     *memOutboxRepo
+	user                       uuid.UUID
+	favourited, archived, free uuid.UUID
+	favourite                  domain.Favourite
+}
+
+func newFavouritesFixture(t *testing.T) favouritesFixture {
+	t.Helper()
+	fx := favouritesFixture{user: uuid.New(), favourited: uuid.New(), archived: uuid.New(), free: uuid.New()}
+	archivedAt := time.Now().UTC()
+	fx.assets = &catalogueRepo{assets: map[uuid.UUID]domain.Asset{
+		fx.favourited: {ID: fx.favourited, Type: domain.AssetTypeChart},
+		fx.archived:   {ID: fx.archived, Type: domain.AssetTypeChart, ArchivedAt: &archivedAt},
+		fx.free:       {ID: fx.free, Type: domain.AssetTypeChart},
+	}}
+	fx.outbox = &memOutboxRepo{}
+	favs := &favouritesRepo{}
+	fx.favService = NewFavouritesService(inlineUnitOfWork{}, usersRepo{known: []uuid.UUID{fx.user}}, fx.assets, favs, nil, fx.outbox, nil)
+
+	var err error
+	if fx.favourite, err = fx.favService.Add(context.Background(), fx.user, fx.favourited, ""); err != nil {
+		t.Fatal(err)
+	}
+	return fx
+}
+
+// archive archives an asset after it was favourited.
+func (fx favouritesFixture) archive(assetID uuid.UUID) {
+	a := fx.assets.assets[assetID]
+	now := time.Now().UTC()
+	a.ArchivedAt = &now
+	fx.assets.assets[assetID] = a
+}
+
+func TestPutIsIdempotent(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("creates", func(t *testing.T) {
+		fx := newFavouritesFixture(t)
+		f, created, err := fx.favService.Put(ctx, fx.user, fx.free)
+		if err != nil || !created || f.AssetID != fx.free {
+			t.Fatalf("Put() = %v, %v, %v, want a new favourite", f, created, err)
+		}
+	})
+
+	t.Run("returns the existing favourite", func(t *testing.T) {
+		fx := newFavouritesFixture(t)
+		f, created, err := fx.favService.Put(ctx, fx.user, fx.favourited)
+		if err != nil || created || f.ID != fx.favourite.ID {
+			t.Fatalf("Put() = %v, %v, %v, want the existing favourite", f, created, err)
+		}
+	})
+
+	t.Run("returns the existing favourite of an asset archived since", func(t *testing.T) {
+		fx := newFavouritesFixture(t)
+		fx.archive(fx.favourited)
+		f, created, err := fx.favService.Put(ctx, fx.user, fx.favourited)
+		if err != nil || created || f.ID != fx.favourite.ID {
+			t.Fatalf("Put() = %v, %v, %v, want the existing favourite", f, created, err)
+		}
+	})
+
+	t.Run("refuses new favourites of archived assets", func(t *testing.T) {
+		fx := newFavouritesFixture(t)
+		if _, _, err := fx.favService.Put(ctx, fx.user, fx.archived); !errors.Is(err, domain.ErrAssetArchived) {
+			t.Fatalf("Put() error = %v, want %v", err, domain.ErrAssetArchived)
+		}
+	})
+}
+
+func TestPutRecordsEventsOnlyForNewFavourites(t *testing.T) {
+	ctx := context.Background()
+	fx := newFavouritesFixture(t)
+	if len(fx.outbox.events) != 1 {
+		t.Fatalf("Add() recorded %d events, want 1", len(fx.outbox.events))
+	}
+
+	if _, _, err := fx.favService.Put(ctx, fx.user, fx.favourited); err != nil {
+		t.Fatal(err)
+	}
+	if len(fx.outbox.events) != 1 {
+		t.Fatalf("Put() of an existing favourite recorded %v", fx.outbox.events[1:])
+	}
+
+	f, _, err := fx.favService.Put(ctx, fx.user, fx.free)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fx.outbox.events) != 2 {
+		t.Fatalf("Put() of a new favourite recorded %d events, want 1", len(fx.outbox.events)-1)
+	}
+	event := fx.outbox.events[1]
+	var data favouriteEventData
+	if err := json.Unmarshal(event.Payload, &data); err != nil {
+		t.Fatal(err)
+	}
+	if event.Type != domain.EventFavouriteAdded || data.UserID != fx.user || data.AssetID != fx.free ||
+		data.FavouriteID == nil || *data.FavouriteID != f.ID || !data.FavouritedAt.Equal(f.CreatedAt) {
+		t.Fatalf("Put() recorded %s %s, want the addition of %+v", event.Type, event.Payload, f)
+	}
+}
+
+func TestAddsLockTheAssetWithinTheirTransaction(t *testing.T) {
+	ctx := context.Background()
+	user, assetID := uuid.New(), uuid.New()
+	assets := &catalogueRepo{assets: map[uuid.UUID]domain.Asset{assetID: {ID: assetID, Type: domain.AssetTypeChart}}}
+
+	adds := map[string]func(favService *FavouritesService) error{
+		"Add": func(favService *FavouritesService) error {
+			_, err := favService.Add(ctx, user, assetID, "")
+			return err
+		},
+		"Put": func(favService *FavouritesService) error {
+			_, _, err := favService.Put(ctx, user, assetID)
+			return err
+		},
+	}
+
+	for name, add := range adds {
+		t.Run(name, func(t *testing.T) {
+			favService := NewFavouritesService(txUnitOfWork{}, usersRepo{known: []uuid.UUID{user}},
+				txCatalogueRepo{assets}, txFavouritesRepo{&favouritesRepo{}}, nil, &memOutboxRepo{}, nil)
+			if err := add(favService); err != nil {
+				t.Fatalf("%s() error = %v", name, err)
+			}
+
+			// Without a transaction the fakes refuse the locking read and the insert.
+			favService = NewFavouritesService(inlineUnitOfWork{}, usersRepo{known: []uuid.UUID{user}},
+				txCatalogueRepo{assets}, txFavouritesRepo{&favouritesRepo{}}, nil, &memOutboxRepo{}, nil)
+			if err := add(favService); !errors.Is(err, errNoTx) {
+				t.Fatalf("%s() outside a transaction error = %v, want %v", name, err, errNoTx)
+			}
+		})
+	}
+}
+
+var errNoTx = errors.New("no transaction")
+
+type txKey struct{}
+
+// txUnitOfWork marks the context of its transactions, so the tx* fakes can tell they run inside one.
+type txUnitOfWork struct{}
+
+func (txUnitOfWork) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(context.WithValue(ctx, txKey{}, true))
+}
+
+func inTx(ctx context.Context) bool { return ctx.Value(txKey{}) != nil }
+
+// txCatalogueRepo only allows locking reads inside a transaction.
+type txCatalogueRepo struct{ *catalogueRepo }
+
+func (repo txCatalogueRepo) GetForShare(ctx context.Context, id uuid.UUID) (*domain.Asset, error) {
+	if !inTx(ctx) {
+		return nil, errNoTx
+	}
+	return repo.catalogueRepo.GetForShare(ctx, id)
+}
+
+// txFavouritesRepo only allows inserts inside a transaction.
+type txFavouritesRepo struct{ *favouritesRepo }
+
+func (repo txFavouritesRepo) Create(ctx context.Context, f *domain.Favourite) error {
+	if !inTx(ctx) {
+		return errNoTx
+	}
+	return repo.favouritesRepo.Create(ctx, f)
+}
+
+func TestGetHidesFavouritesOfArchivedAssets(t *testing.T) {
+	ctx := context.Background()
+	fx := newFavouritesFixture(t)
+
+	if f, err := fx.favService.Get(ctx, fx.user, fx.favourited); err != nil || f.ID != fx.favourite.ID {
+		t.Fatalf("Get() = %v, %v, want the favourite", f, err)
+	}
+	if _, err := fx.favService.Get(ctx, uuid.New(), fx.favourited); !errors.Is(err, domain.ErrUserNotFound) {
+		t.Fatalf("Get() of an unknown user error = %v, want %v", err, domain.ErrUserNotFound)
+	}
+
+	fx.archive(fx.favourited)
+	if _, err := fx.favService.Get(ctx, fx.user, fx.favourited); !errors.Is(err, domain.ErrFavouriteNotFound) {
+		t.Fatalf("Get() of an archived asset error = %v, want %v", err, domain.ErrFavouriteNotFound)
+	}
+}
+
+func TestStatusHidesFavouritesOfArchivedAssets(t *testing.T) {
+	ctx := context.Background()
+	fx := newFavouritesFixture(t)
+	ids := []uuid.UUID{fx.favourited, fx.free}
+
+	status, err := fx.favService.Status(ctx, fx.user, ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status[fx.favourited] == nil || status[fx.free] != nil {
+		t.Fatalf("Status() = %v, want only %s favourited", status, fx.favourited)
+	}
+
+	fx.archive(fx.favourited)
+	status, err = fx.favService.Status(ctx, fx.user, ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(status) != 2 || status[fx.favourited] != nil {
+		t.Fatalf("Status() = %v, want both assets unfavourited", status)
+	}
+}
+
+// listedFavouritesRepo is a ports.FavouriteRepository recording the filter it was listed with.
+type listedFavouritesRepo struct {
+	ports.FavouriteRepository
+	filter *ports.FavouriteFilter
+}
+
+func (repo *listedFavouritesRepo) ListAssetsFavouritedByUserKeyset(
+	_ context.Context, _ uuid.UUID, filter ports.FavouriteFilter, _ int, _, _ string,
+) ([]domain.FavouritedAsset, ports.PageCursors, error) {
+	repo.filter = &filter
+	return nil, ports.PageCursors{}, nil
+}
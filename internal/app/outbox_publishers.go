@@ -0,0 +1,87 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/ports"
+)
+
+// Ensure ports.OutboxPublisher interface implementations.
+var (
+	_ ports.OutboxPublisher = (*LogPublisher)(nil)
+	_ ports.OutboxPublisher = (*LivePublisher)(nil)
+)
+
+// LogPublisher writes every outbox event to the log.
+type LogPublisher struct {
+	log *slog.Logger
+}
+
+func NewLogPublisher(log *slog.Logger) *LogPublisher {
+	return &LogPublisher{log: log}
+}
+
+func (logPublisher *LogPublisher) Name() string {
+	return "log"
+}
+
+func (logPublisher *LogPublisher) Publish(ctx context.Context, event domain.OutboxEvent) error {
+	logPublisher.log.InfoContext(ctx, "domain event",
+		"event_id", event.ID,
+		"type", event.Type,
+		"created_at", event.CreatedAt,
+		"payload", json.RawMessage(event.Payload),
+	)
+	return nil
+}
+
+// LivePublisher hands favourite events to the in-memory event broker behind the live streams.
+// Other events are skipped.
+type LivePublisher struct {
+	events ports.EventPublisher
+}
+
+func NewLivePublisher(events ports.EventPublisher) *LivePublisher {
+	return &LivePublisher{events: events}
+}
+
+func (livePublisher *LivePublisher) Name() string {
+	return "live"
+}
+
+func (livePublisher *LivePublisher) Publish(_ context.Context, event domain.OutboxEvent) error {
+	var kind domain.ChangeKind
+	switch event.Type {
+	case domain.EventFavouriteAdded:
+		kind = domain.ChangeAdded
+	case domain.EventFavouriteRemoved:
+		kind = domain.ChangeRemoved
+	case domain.EventFavouriteNoteEdited:
+		kind = domain.ChangeNoteEdited
+	default:
+		return nil
+	}
+
+	var data favouriteEventData
+	if err := json.Unmarshal(event.Payload, &data); err != nil {
+		return err
+	}
+
+	live := domain.FavouriteEvent{UserID: data.UserID, Kind: kind, AssetID: data.AssetID, At: event.CreatedAt}
+	if data.FavouriteID != nil && data.FavouritedAt != nil {
+		live.Favourite = &domain.Favourite{
+			ID:        *data.FavouriteID,
+			UserID:    data.UserID,
+			AssetID:   data.AssetID,
+			CreatedAt: *data.FavouritedAt,
+		}
+		if kind == domain.ChangeAdded {
+			live.At = *data.FavouritedAt
+		}
+	}
+	livePublisher.events.Publish(live)
+	return nil
+}
@@ -0,0 +1,173 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/ports"
+)
+
+// outboxClaimBatch is how many events one relay round claims.
+const outboxClaimBatch = 50
+
+// outboxLease keeps a claimed event away from other relays while it is published.
+const outboxLease = time.Minute
+
+// OutboxRelay drains the outbox: every committed event is handed to all publishers, oldest first.
+// An event is marked published only once every publisher accepted it; otherwise it is retried with
+// backoff, for the publishers that failed only. Publishers see each event at least once, and again
+// only when the relay stops between publishing an event and recording the outcome.
+type OutboxRelay struct {
+	outboxRepo ports.OutboxRepository
+	publishers []ports.OutboxPublisher
+	backoff    time.Duration // delay after the first failed attempt, doubled per attempt
+
+	// Metrics: pending, lag_seconds (age of the oldest pending event), published, failures
+	// and publish_delay_seconds (commit to publish of the last published event).
+	metrics      *expvar.Map
+	pending      *expvar.Int
+	lag          *expvar.Float
+	published    *expvar.Int
+	failures     *expvar.Int
+	publishDelay *expvar.Float
+}
+
+func NewOutboxRelay(outboxRepo ports.OutboxRepository, publishers []ports.OutboxPublisher, backoff time.Duration) *OutboxRelay {
+	relay := &OutboxRelay{
+		outboxRepo:   outboxRepo,
+		publishers:   publishers,
+		backoff:      backoff,
+		metrics:      new(expvar.Map),
+		pending:      new(expvar.Int),
+		lag:          new(expvar.Float),
+		published:    new(expvar.Int),
+		failures:     new(expvar.Int),
+		publishDelay: new(expvar.Float),
+	}
+	relay.metrics.Set("pending", relay.pending)
+	relay.metrics.Set("lag_seconds", relay.lag)
+	relay.metrics.Set("published", relay.published)
+	relay.metrics.Set("failures", relay.failures)
+	relay.metrics.Set("publish_delay_seconds", relay.publishDelay)
+	return relay
+}
+
+// Metrics returns the relay metrics, for the caller to publish (e.g. with expvar.Publish).
+func (relay *OutboxRelay) Metrics() expvar.Var {
+	return relay.metrics
+}
+
+// RelayPending claims the pending events and publishes each of them. It returns how many events were
+// claimed. Publish failures are recorded on the event; only storage errors are returned.
+func (relay *OutboxRelay) RelayPending(ctx context.Context, log *slog.Logger) (int, error) {
+	events, err := relay.outboxRepo.ClaimPending(ctx, time.Now().UTC(), outboxLease, outboxClaimBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range events {
+		e := &events[i]
+		pubErr := relay.publish(ctx, e)
+		if ctx.Err() != nil {
+			// Shutting down: the lease runs out and the event is published again later.
+			return i, ctx.Err()
+		}
+
+		now := time.Now().UTC()
+		if pubErr != nil {
+			relay.failures.Add(1)
+			log.Warn("outbox event publish failed",
+				"event_id", e.ID, "type", e.Type, "attempts", e.Attempts+1, "err", pubErr)
+			e.RecordFailure(now, pubErr, relay.backoff)
+			if err := relay.outboxRepo.RecordFailure(ctx, e); err != nil {
+				return i + 1, err
+			}
+			continue
+		}
+
+		if err := relay.outboxRepo.MarkPublished(ctx, e.ID, now); err != nil {
+			return i + 1, err
+		}
+		relay.published.Add(1)
+		relay.publishDelay.Set(now.Sub(e.CreatedAt).Seconds())
+	}
+	return len(events), nil
+}
+
+// publish hands the event to every publisher that has not accepted it yet, so one failing publisher
+// doesn't hold back the others, and adds those that accept it to e.PublishedTo.
+func (relay *OutboxRelay) publish(ctx context.Context, e *domain.OutboxEvent) error {
+	var errs []error
+	for _, p := range relay.publishers {
+		if slices.Contains(e.PublishedTo, p.Name()) {
+			continue
+		}
+		if err := p.Publish(ctx, *e); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", p.Name(), err))
+			continue
+		}
+		e.PublishedTo = append(e.PublishedTo, p.Name())
+	}
+	return errors.Join(errs...)
+}
+
+// UpdateMetrics refreshes the backlog metrics.
+func (relay *OutboxRelay) UpdateMetrics(ctx context.Context) error {
+	count, oldest, err := relay.outboxRepo.Backlog(ctx)
+	if err != nil {
+		return err
+	}
+	relay.pending.Set(int64(count))
+	if oldest == nil {
+		relay.lag.Set(0)
+	} else {
+		relay.lag.Set(time.Since(*oldest).Seconds())
+	}
+	return nil
+}
+
+// Prune deletes events published longer than retention ago.
+func (relay *OutboxRelay) Prune(ctx context.Context, retention time.Duration) (int, error) {
+	return relay.outboxRepo.PrunePublished(ctx, time.Now().UTC().Add(-retention))
+}
+
+// Run relays pending events every interval until ctx is cancelled. A full batch is followed
+// by another round right away. Failures are logged and retried on the next tick.
+func (relay *OutboxRelay) Run(ctx context.Context, log *slog.Logger, every time.Duration) {
+	ticker := time.NewTicker(every)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for {
+			n, err := relay.RelayPending(ctx, log)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Error("outbox relay failed", "err", err)
+				}
+				break
+			}
+			if n > 0 {
+				log.Debug("outbox events relayed", "count", n)
+			}
+			if n < outboxClaimBatch {
+				break
+			}
+		}
+
+		if err := relay.UpdateMetrics(ctx); err != nil && ctx.Err() == nil {
+			log.Error("outbox metrics update failed", "err", err)
+		}
+	}
+}
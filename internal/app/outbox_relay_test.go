@@ -0,0 +1,128 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/ports"
+	"github.com/google/uuid"
+)
+
+func TestRelayRetriesOnlyFailedPublishers(t *testing.T) {
+	outbox := &memOutboxRepo{}
+	event := domain.NewOutboxEvent(domain.EventFavouriteAdded, []byte(`{}`))
+	if err := outbox.Append(context.Background(), []domain.OutboxEvent{event}); err != nil {
+		t.Fatal(err)
+	}
+
+	stable := &countingPublisher{name: "stable"}
+	flaky := &countingPublisher{name: "flaky", failures: 1}
+	relay := NewOutboxRelay(outbox, []ports.OutboxPublisher{stable, flaky}, time.Minute)
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	// Round 1: flaky fails, the event stays pending.
+	if _, err := relay.RelayPending(context.Background(), log); err != nil {
+		t.Fatal(err)
+	}
+	e := outbox.events[0]
+	if e.PublishedAt != nil || e.Attempts != 1 || !slices.Equal(e.PublishedTo, []string{"stable"}) {
+		t.Fatalf("after a failure: published at %v, %d attempts, published to %v", e.PublishedAt, e.Attempts, e.PublishedTo)
+	}
+
+	// Round 2: only flaky is retried.
+	outbox.events[0].AvailableAt = time.Now().UTC()
+	if _, err := relay.RelayPending(context.Background(), log); err != nil {
+		t.Fatal(err)
+	}
+	if outbox.events[0].PublishedAt == nil {
+		t.Fatal("event not marked published after every publisher accepted it")
+	}
+	if stable.calls != 1 || flaky.calls != 2 {
+		t.Fatalf("publish calls: stable %d, flaky %d, want 1 and 2", stable.calls, flaky.calls)
+	}
+}
+
+// countingPublisher counts its calls and fails the first failures of them.
+type countingPublisher struct {
+	name     string
+	failures int
+	calls    int
+}
+
+func (publisher *countingPublisher) Name() string {
+	return publisher.name
+}
+
+func (publisher *countingPublisher) Publish(context.Context, domain.OutboxEvent) error {
+	publisher.calls++
+	if publisher.calls <= publisher.failures {
+		return errors.New("unavailable")
+	}
+	return nil
+}
+
+// memOutboxRepo is an in-memory ports.OutboxRepository for a single relay.
+type memOutboxRepo struct {
+	events []domain.OutboxEvent
+}
+
+var _ ports.OutboxRepository = (*memOutboxRepo)(nil)
+
+func (outbox *memOutboxRepo) Append(_ context.Context, events []domain.OutboxEvent) error {
+	for i := range events {
+		events[i].ID = uuid.New()
+		outbox.events = append(outbox.events, events[i])
+	}
+	return nil
+}
+
+func (outbox *memOutboxRepo) ClaimPending(_ context.Context, now time.Time, lease time.Duration, limit int) ([]domain.OutboxEvent, error) {
+	var claimed []domain.OutboxEvent
+	for i := range outbox.events {
+		e := &outbox.events[i]
+		if len(claimed) == limit || e.PublishedAt != nil || e.AvailableAt.After(now) {
+			continue
+		}
+		e.AvailableAt = now.Add(lease)
+		claimed = append(claimed, *e)
+	}
+	return claimed, nil
+}
+
+func (outbox *memOutboxRepo) MarkPublished(_ context.Context, id uuid.UUID, at time.Time) error {
+	e := outbox.find(id)
+	e.PublishedAt = &at
+	return nil
+}
+
+func (outbox *memOutboxRepo) RecordFailure(_ context.Context, e *domain.OutboxEvent) error {
+	stored := outbox.find(e.ID)
+	stored.Attempts = e.Attempts
+	stored.LastError = e.LastError
+	stored.PublishedTo = slices.Clone(e.PublishedTo)
+	stored.AvailableAt = e.AvailableAt
+	return nil
+}
+
+func (outbox *memOutboxRepo) Backlog(context.Context) (int, *time.Time, error) {
+	return 0, nil, nil
+}
+
+func (outbox *memOutboxRepo) PrunePublished(context.Context, time.Time) (int, error) {
+	return 0, nil
+}
+
+func (outbox *memOutboxRepo) find(id uuid.UUID) *domain.OutboxEvent {
+	for i := range outbox.events {
+		if outbox.events[i].ID == id {
+			return &outbox.events[i]
+		}
+	}
+	return nil
+}
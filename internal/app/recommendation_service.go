@@ -0,0 +1,292 @@
+package app
+
+import (
+	"cmp"
+	"context"
+	"log/slog"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/ports"
+	"github.com/google/uuid"
+)
+
+// refreshBatchSize is how many favourites one refresh query reads.
+const refreshBatchSize = 1000
+
+// RecommendationService answers "users who favourited this also favourited" queries from an
+// in-memory item-to-item co-occurrence model over the favourites table.
+//
+// The model is fed incrementally: Refresh applies the favourite additions and removals committed
+// since the last feed position it saw. Rebuild replays the feed from the beginning into a fresh
+// model, which also forgets removals whose tombstones were pruned.
+type RecommendationService struct {
+	userRepo  ports.UserRepository
+	assetRepo ports.AssetRepository
+	favRepo   ports.FavouriteRepository
+
+	mu    sync.RWMutex
+	model *coOccurrence
+}
+
+func NewRecommendationService(
+	userRepo ports.UserRepository,
+	assetRepo ports.AssetRepository,
+	favRepo ports.FavouriteRepository,
+) *RecommendationService {
+	return &RecommendationService{
+		userRepo:  userRepo,
+		assetRepo: assetRepo,
+		favRepo:   favRepo,
+		model:     newCoOccurrence(),
+	}
+}
+
+// Related returns the assets most often favourited by users who favourited assetID.
+func (recService *RecommendationService) Related(ctx context.Context, assetID uuid.UUID, limit int) ([]domain.RecommendedAsset, error) {
+	if _, err := recService.assetRepo.Get(ctx, assetID); err != nil {
+		return nil, err // expected: domain.ErrAssetNotFound
+	}
+
+	recService.mu.RLock()
+	scores := maps.Clone(recService.model.pairs[assetID])
+	recService.mu.RUnlock()
+
+	return recService.rank(ctx, scores, nil, limit)
+}
+
+// Recommendations suggests assets co-favourited with the user's favourites, excluding the
+// assets the user already favourited.
+func (recService *RecommendationService) Recommendations(ctx context.Context, userID uuid.UUID, limit int) ([]domain.RecommendedAsset, error) {
+	ok, err := recService.userRepo.Exists(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, domain.ErrUserNotFound
+	}
+
+	recService.mu.RLock()
+	owned := recService.model.users[userID]
+	scores := make(map[uuid.UUID]int)
+	for seed := range owned {
+		for other, n := range recService.model.pairs[seed] {
+			if _, mine := owned[other]; !mine {
+				scores[other] += n
+			}
+		}
+	}
+	recService.mu.RUnlock()
+
+	return recService.rank(ctx, scores, &userID, limit)
+}
+
+// rank orders candidates by score (ties by asset id) and loads the top ones, skipping archived
+// or deleted assets and, for a user, assets favourited since the model last saw them.
+func (recService *RecommendationService) rank(
+	ctx context.Context, scores map[uuid.UUID]int, userID *uuid.UUID, limit int,
+) ([]domain.RecommendedAsset, error) {
+	ids := make([]uuid.UUID, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+	slices.SortFunc(ids, func(a, b uuid.UUID) int {
+		if c := cmp.Compare(scores[b], scores[a]); c != 0 {
+			return c
+		}
+		return slices.Compare(a[:], b[:])
+	})
+
+	out := make([]domain.RecommendedAsset, 0, limit)
+	// Load candidates a page at a time; filtering may drop some.
+	for start := 0; start < len(ids) && len(out) < limit; start += domain.MaxBatchSize {
+		page := ids[start:min(start+domain.MaxBatchSize, len(ids))]
+
+		assets, err := recService.assetRepo.GetMany(ctx, page)
+		if err != nil {
+			return nil, err
+		}
+		byID := make(map[uuid.UUID]domain.Asset, len(assets))
+		for _, a := range assets {
+			if !a.IsArchived() {
+				byID[a.ID] = a
+			}
+		}
+		if userID != nil {
+			favs, err := recService.favRepo.GetMany(ctx, *userID, page)
+			if err != nil {
+				return nil, err
+			}
+			for _, f := range favs {
+				delete(byID, f.AssetID)
+			}
+		}
+
+		for _, id := range page {
+			if a, ok := byID[id]; ok && len(out) < limit {
+				out = append(out, domain.RecommendedAsset{Asset: a, Score: scores[id]})
+			}
+		}
+	}
+	return out, nil
+}
+
+// Refresh applies the favourite additions and removals committed since the last refresh to the model.
+func (recService *RecommendationService) Refresh(ctx context.Context) error {
+	recService.mu.RLock()
+	model := recService.model
+	recService.mu.RUnlock()
+
+	return recService.feed(ctx, model, &recService.mu)
+}
+
+// Rebuild recomputes the model from scratch and swaps it in.
+func (recService *RecommendationService) Rebuild(ctx context.Context) error {
+	model := newCoOccurrence()
+	// The new model is private until swapped in, so it needs no lock while loading.
+	if err := recService.feed(ctx, model, nil); err != nil {
+		return err
+	}
+
+	recService.mu.Lock()
+	recService.model = model
+	recService.mu.Unlock()
+	return nil
+}
+
+// feed applies the favourite changes after model's position in batches, holding mu (if any) per batch.
+func (recService *RecommendationService) feed(ctx context.Context, model *coOccurrence, mu *sync.RWMutex) error {
+	for {
+		lock(mu)
+		pos := model.pos
+		unlock(mu)
+
+		deltas, next, err := recService.favRepo.ListFeed(ctx, pos, refreshBatchSize)
+		if err != nil {
+			return err
+		}
+
+		lock(mu)
+		for _, d := range deltas {
+			if d.Kind == domain.ChangeRemoved {
+				model.remove(d.UserID, d.AssetID)
+			} else {
+				model.add(d.UserID, d.AssetID)
+			}
+		}
+		model.pos = next
+		unlock(mu)
+
+		if len(deltas) < refreshBatchSize {
+			return nil
+		}
+	}
+}
+
+// Run rebuilds the model, then refreshes it every refreshEvery and rebuilds it every rebuildEvery
+// until ctx is cancelled. Failures are logged and retried on the next tick.
+func (recService *RecommendationService) Run(ctx context.Context, log *slog.Logger, refreshEvery, rebuildEvery time.Duration) {
+	if err := recService.Rebuild(ctx); err != nil && ctx.Err() == nil {
+		log.Error("recommender initial build failed", "err", err)
+	}
+
+	refresh := time.NewTicker(refreshEvery)
+	defer refresh.Stop()
+	rebuild := time.NewTicker(rebuildEvery)
+	defer rebuild.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-refresh.C:
+			if err := recService.Refresh(ctx); err != nil && ctx.Err() == nil {
+				log.Error("recommender refresh failed", "err", err)
+			}
+		case <-rebuild.C:
+			if err := recService.Rebuild(ctx); err != nil && ctx.Err() == nil {
+				log.Error("recommender rebuild failed", "err", err)
+			}
+		}
+	}
+}
+
+func lock(mu *sync.RWMutex) {
+	if mu != nil {
+		mu.Lock()
+	}
+}
+
+func unlock(mu *sync.RWMutex) {
+	if mu != nil {
+		mu.Unlock()
+	}
+}
+
+// coOccurrence counts, for every pair of assets, how many users favourited both.
+type coOccurrence struct {
+	users map[uuid.UUID]map[uuid.UUID]struct{} // user -> favourited assets
+	pairs map[uuid.UUID]map[uuid.UUID]int      // asset -> co-favourited asset -> users
+
+	// Position of the last change applied.
+	pos domain.FeedPosition
+}
+
+func newCoOccurrence() *coOccurrence {
+	return &coOccurrence{
+		users: make(map[uuid.UUID]map[uuid.UUID]struct{}),
+		pairs: make(map[uuid.UUID]map[uuid.UUID]int),
+	}
+}
+
+// add records one favourite, pairing its asset with every other asset of the same user.
+func (model *coOccurrence) add(userID, assetID uuid.UUID) {
+	owned := model.users[userID]
+	if owned == nil {
+		owned = make(map[uuid.UUID]struct{})
+		model.users[userID] = owned
+	}
+	if _, dup := owned[assetID]; dup {
+		return
+	}
+	for other := range owned {
+		model.bump(assetID, other, 1)
+		model.bump(other, assetID, 1)
+	}
+	owned[assetID] = struct{}{}
+}
+
+// remove undoes add: it forgets the favourite and unpairs its asset from the user's other assets.
+func (model *coOccurrence) remove(userID, assetID uuid.UUID) {
+	owned := model.users[userID]
+	if _, ok := owned[assetID]; !ok {
+		return
+	}
+	delete(owned, assetID)
+	if len(owned) == 0 {
+		delete(model.users, userID)
+	}
+	for other := range owned {
+		model.bump(assetID, other, -1)
+		model.bump(other, assetID, -1)
+	}
+}
+
+// bump changes the count of the (a, b) pair by n, dropping pairs that reach zero.
+func (model *coOccurrence) bump(a, b uuid.UUID, n int) {
+	row := model.pairs[a]
+	if row == nil {
+		row = make(map[uuid.UUID]int)
+		model.pairs[a] = row
+	}
+	row[b] += n
+	if row[b] <= 0 {
+		delete(row, b)
+		if len(row) == 0 {
+			delete(model.pairs, a)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/ports"
+	"github.com/google/uuid"
+)
+
+func TestRefreshForgetsRemovedFavourites(t *testing.T) {
+	alice, bob := uuid.New(), uuid.New()
+	chart, insight, audience := uuid.New(), uuid.New(), uuid.New()
+
+	feed := &feedRepo{}
+	assets := &catalogueRepo{assets: map[uuid.UUID]domain.Asset{
+		chart:    {ID: chart, Type: domain.AssetTypeChart},
+		insight:  {ID: insight, Type: domain.AssetTypeInsight},
+		audience: {ID: audience, Type: domain.AssetTypeAudience},
+	}}
+	recService := NewRecommendationService(usersRepo{known: []uuid.UUID{alice, bob}}, assets, feed)
+	ctx := context.Background()
+
+	// Alice likes chart and insight; Bob likes chart.
+	feed.commit(domain.ChangeAdded, alice, chart)
+	feed.commit(domain.ChangeAdded, alice, insight)
+	feed.commit(domain.ChangeAdded, bob, chart)
+	if err := recService.Rebuild(ctx); err != nil {
+		t.Fatal(err)
+	}
+	assertRecommended(t, recService, bob, insight)
+	assertRelated(t, recService, chart, insight)
+
+	// Alice drops insight and adds audience: a refresh, not a rebuild, follows both changes.
+	feed.commit(domain.ChangeRemoved, alice, insight)
+	feed.commit(domain.ChangeAdded, alice, audience)
+	if err := recService.Refresh(ctx); err != nil {
+		t.Fatal(err)
+	}
+	assertRecommended(t, recService, bob, audience)
+	assertRelated(t, recService, chart, audience)
+	assertRelated(t, recService, insight)
+
+	// Alice drops everything.
+	feed.commit(domain.ChangeRemoved, alice, chart)
+	feed.commit(domain.ChangeRemoved, alice, audience)
+	if err := recService.Refresh(ctx); err != nil {
+		t.Fatal(err)
+	}
+	assertRecommended(t, recService, bob)
+	assertRelated(t, recService, chart)
+}
+
+func assertRecommended(t *testing.T, recService *RecommendationService, userID uuid.UUID, want ...uuid.UUID) {
+	t.Helper()
+	got, err := recService.Recommendations(context.Background(), userID, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertAssets(t, "Recommendations", got, want)
+}
+
+func assertRelated(t *testing.T, recService *RecommendationService, assetID uuid.UUID, want ...uuid.UUID) {
+	t.Helper()
+	got, err := recService.Related(context.Background(), assetID, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertAssets(t, "Related", got, want)
+}
+
+func assertAssets(t *testing.T, name string, got []domain.RecommendedAsset, want []uuid.UUID) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("%s() returned %d assets, want %d", name, len(got), len(want))
+	}
+	for i := range want {
+		if got[i].Asset.ID != want[i] || got[i].Score != 1 {
+			t.Fatalf("%s()[%d] = %s (score %d), want %s (score 1)", name, i, got[i].Asset.ID, got[i].Score, want[i])
+		}
+	}
+}
+
+// feedRepo is a ports.FavouriteRepository serving the feed of the changes committed through it.
+type feedRepo struct {
+	ports.FavouriteRepository
+	changes []domain.FavouriteDelta
+	current map[[2]uuid.UUID]bool
+}
+
+func (repo *feedRepo) commit(kind domain.ChangeKind, userID, assetID uuid.UUID) {
+	repo.changes = append(repo.changes, domain.FavouriteDelta{Kind: kind, UserID: userID, AssetID: assetID})
+	if repo.current == nil {
+		repo.current = make(map[[2]uuid.UUID]bool)
+	}
+	repo.current[[2]uuid.UUID{userID, assetID}] = kind == domain.ChangeAdded
+}
+
+// ListFeed positions the changes by their index, as if each had its own transaction.
+func (repo *feedRepo) ListFeed(_ context.Context, after domain.FeedPosition, limit int) ([]domain.FavouriteDelta, domain.FeedPosition, error) {
+	start := int(after.Tx)
+	end := min(start+limit, len(repo.changes))
+	return repo.changes[start:end], domain.FeedPosition{Tx: int64(end)}, nil
+}
+
+func (repo *feedRepo) GetMany(_ context.Context, userID uuid.UUID, assetIDs []uuid.UUID) ([]domain.Favourite, error) {
+	var favs []domain.Favourite
+	for _, assetID := range assetIDs {
+		if repo.current[[2]uuid.UUID{userID, assetID}] {
+			favs = append(favs, domain.Favourite{UserID: userID, AssetID: assetID})
+		}
+	}
+	return favs, nil
+}
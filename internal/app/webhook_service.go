@@ -0,0 +1,267 @@
+package app
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/ports"
+	"github.com/google/uuid"
+)
+
+// webhookClaimBatch is how many due deliveries one worker round claims.
+const webhookClaimBatch = 20
+
+// webhookLease keeps a claimed delivery away from other workers while it is attempted.
+// It must outlast the sender's request timeout.
+const webhookLease = time.Minute
+
+// Ensure ports.OutboxPublisher interface implementation.
+var _ ports.OutboxPublisher = (*WebhookService)(nil)
+
+// WebhookService manages webhook subscriptions, queues outbox events for them and delivers them.
+type WebhookService struct {
+	webhookRepo ports.WebhookRepository
+	sender      ports.WebhookSender
+	backoff     time.Duration // delay after the first failed attempt, doubled per attempt
+}
+
+func NewWebhookService(webhookRepo ports.WebhookRepository, sender ports.WebhookSender, backoff time.Duration) *WebhookService {
+	return &WebhookService{webhookRepo: webhookRepo, sender: sender, backoff: backoff}
+}
+
+// webhookEnvelope is the JSON body of every delivery.
+type webhookEnvelope struct {
+	ID        uuid.UUID               `json:"id"`
+	Type      domain.WebhookEventType `json:"type"`
+	CreatedAt time.Time               `json:"created_at"`
+	Data      json.RawMessage         `json:"data"`
+}
+
+// Create registers a receiver for eventTypes with a freshly generated signing secret.
+func (webhookService *WebhookService) Create(ctx context.Context, url string, eventTypes []domain.WebhookEventType) (domain.WebhookSubscription, error) {
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return domain.WebhookSubscription{}, err
+	}
+	s, err := domain.NewWebhookSubscription(url, eventTypes, secret)
+	if err != nil {
+		return domain.WebhookSubscription{}, err // expected: domain.ErrInvalidWebhookURL, domain.ErrInvalidWebhookEvents
+	}
+	if err := webhookService.checkURL(ctx, s.URL); err != nil {
+		return domain.WebhookSubscription{}, err
+	}
+	if err := webhookService.webhookRepo.CreateSubscription(ctx, &s); err != nil {
+		return domain.WebhookSubscription{}, err
+	}
+	return s, nil
+}
+
+// checkURL refuses receivers the sender won't deliver to (e.g. internal addresses) with
+// domain.ErrInvalidWebhookURL.
+func (webhookService *WebhookService) checkURL(ctx context.Context, rawURL string) error {
+	if err := webhookService.sender.CheckURL(ctx, rawURL); err != nil {
+		return fmt.Errorf("%w: %v", domain.ErrInvalidWebhookURL, err)
+	}
+	return nil
+}
+
+// List returns all subscriptions.
+func (webhookService *WebhookService) List(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	return webhookService.webhookRepo.ListSubscriptions(ctx)
+}
+
+// Get returns a subscription or domain.ErrWebhookNotFound.
+func (webhookService *WebhookService) Get(ctx context.Context, id uuid.UUID) (*domain.WebhookSubscription, error) {
+	return webhookService.webhookRepo.GetSubscription(ctx, id)
+}
+
+// Update changes the URL, event types and/or active flag of a subscription; nil leaves a field as is.
+func (webhookService *WebhookService) Update(
+	ctx context.Context, id uuid.UUID, url *string, eventTypes []domain.WebhookEventType, active *bool,
+) (*domain.WebhookSubscription, error) {
+	s, err := webhookService.webhookRepo.GetSubscription(ctx, id)
+	if err != nil {
+		return nil, err // expected: domain.ErrWebhookNotFound
+	}
+	if url != nil {
+		if err := s.SetURL(*url); err != nil {
+			return nil, err // expected: domain.ErrInvalidWebhookURL
+		}
+		if err := webhookService.checkURL(ctx, s.URL); err != nil {
+			return nil, err
+		}
+	}
+	if eventTypes != nil {
+		if err := s.SetEventTypes(eventTypes); err != nil {
+			return nil, err // expected: domain.ErrInvalidWebhookEvents
+		}
+	}
+	if active != nil {
+		s.Active = *active
+	}
+	if err := webhookService.webhookRepo.UpdateSubscription(ctx, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Delete removes a subscription and its delivery log. Missing should return domain.ErrWebhookNotFound.
+func (webhookService *WebhookService) Delete(ctx context.Context, id uuid.UUID) error {
+	return webhookService.webhookRepo.DeleteSubscription(ctx, id)
+}
+
+// Deliveries returns the delivery log of a subscription, newest first.
+func (webhookService *WebhookService) Deliveries(ctx context.Context, id uuid.UUID, limit int, after string) ([]domain.WebhookDelivery, *string, error) {
+	if _, err := webhookService.webhookRepo.GetSubscription(ctx, id); err != nil {
+		return nil, nil, err // expected: domain.ErrWebhookNotFound
+	}
+	return webhookService.webhookRepo.ListDeliveriesKeyset(ctx, id, limit, after)
+}
+
+// Ping queues a ping event for one subscription, active or not, so its receiver can be checked.
+func (webhookService *WebhookService) Ping(ctx context.Context, id uuid.UUID) (domain.WebhookDelivery, error) {
+	s, err := webhookService.webhookRepo.GetSubscription(ctx, id)
+	if err != nil {
+		return domain.WebhookDelivery{}, err // expected: domain.ErrWebhookNotFound
+	}
+	envelope := webhookEnvelope{ID: uuid.New(), Type: domain.WebhookPing, CreatedAt: time.Now().UTC(), Data: json.RawMessage("{}")}
+	deliveries, err := newDeliveries(envelope, []domain.WebhookSubscription{*s})
+	if err != nil {
+		return domain.WebhookDelivery{}, err
+	}
+	if err := webhookService.webhookRepo.CreateDeliveries(ctx, deliveries); err != nil {
+		return domain.WebhookDelivery{}, err
+	}
+	return deliveries[0], nil
+}
+
+// Name identifies the webhook publisher in relay logs.
+func (webhookService *WebhookService) Name() string {
+	return "webhooks"
+}
+
+// Publish queues an outbox event for the active subscriptions that want it. Events no webhook can
+// be subscribed to are skipped. The envelope ID is the outbox event ID, so receivers can drop the
+// duplicates that at-least-once publishing may produce.
+func (webhookService *WebhookService) Publish(ctx context.Context, event domain.OutboxEvent) error {
+	eventType := domain.WebhookEventType(event.Type)
+	if !eventType.Valid() {
+		return nil
+	}
+	subs, err := webhookService.webhookRepo.ListActiveSubscriptions(ctx)
+	if err != nil {
+		return err
+	}
+	wanted := make([]domain.WebhookSubscription, 0, len(subs))
+	for _, s := range subs {
+		if s.Wants(eventType) {
+			wanted = append(wanted, s)
+		}
+	}
+	if len(wanted) == 0 {
+		return nil
+	}
+
+	envelope := webhookEnvelope{ID: event.ID, Type: eventType, CreatedAt: event.CreatedAt.UTC(), Data: event.Payload}
+	deliveries, err := newDeliveries(envelope, wanted)
+	if err != nil {
+		return err
+	}
+	return webhookService.webhookRepo.CreateDeliveries(ctx, deliveries)
+}
+
+// newDeliveries addresses an event envelope to every subscription.
+func newDeliveries(envelope webhookEnvelope, subs []domain.WebhookSubscription) ([]domain.WebhookDelivery, error) {
+	payload, err := json.Marshal(envelope)
+	if err != nil {
+		return nil, err
+	}
+	deliveries := make([]domain.WebhookDelivery, 0, len(subs))
+	for _, s := range subs {
+		deliveries = append(deliveries, domain.NewWebhookDelivery(s.ID, envelope.ID, envelope.Type, payload))
+	}
+	return deliveries, nil
+}
+
+// DeliverDue claims the deliveries that are due and makes one attempt at each, recording the outcome
+// (and the next retry) on the delivery. It returns how many deliveries were attempted.
+func (webhookService *WebhookService) DeliverDue(ctx context.Context) (int, error) {
+	due, err := webhookService.webhookRepo.ClaimDueDeliveries(ctx, time.Now().UTC(), webhookLease, webhookClaimBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	subs := make(map[uuid.UUID]*domain.WebhookSubscription)
+	for i := range due {
+		d := &due[i]
+		s, ok := subs[d.SubscriptionID]
+		if !ok {
+			s, err = webhookService.webhookRepo.GetSubscription(ctx, d.SubscriptionID)
+			if err != nil && !errors.Is(err, domain.ErrWebhookNotFound) {
+				return i, err
+			}
+			subs[d.SubscriptionID] = s
+		}
+		if s == nil {
+			continue // deleted meanwhile; its deliveries went with it
+		}
+
+		statusCode, sendErr := webhookService.sender.Send(ctx, *s, *d)
+		if ctx.Err() != nil {
+			// Shutting down: the lease runs out and the attempt is made again later.
+			return i, ctx.Err()
+		}
+		d.RecordAttempt(time.Now().UTC(), statusCode, sendErr, webhookService.backoff)
+		if err := webhookService.webhookRepo.UpdateDelivery(ctx, d); err != nil {
+			return i + 1, err
+		}
+	}
+	return len(due), nil
+}
+
+// Run delivers due webhooks every interval until ctx is cancelled. A full batch is followed
+// by another round right away. Failures are logged and retried on the next tick.
+func (webhookService *WebhookService) Run(ctx context.Context, log *slog.Logger, every time.Duration) {
+	ticker := time.NewTicker(every)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for {
+			n, err := webhookService.DeliverDue(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Error("webhook delivery failed", "err", err)
+				}
+				break
+			}
+			if n > 0 {
+				log.Debug("webhook deliveries attempted", "count", n)
+			}
+			if n < webhookClaimBatch {
+				break
+			}
+		}
+	}
+}
+
+// newWebhookSecret returns a random signing secret.
+func newWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
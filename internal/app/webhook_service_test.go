@@ -0,0 +1,370 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/adapters/webhook"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/ports"
+	"github.com/google/uuid"
+)
+
+// testBackoff is the first retry delay in these tests; retries are made due by the test itself.
+const testBackoff = time.Minute
+
+// receiver is an httptest webhook receiver answering with the queued status codes (200 once they run out).
+type receiver struct {
+	mu       sync.Mutex
+	statuses []int
+	requests []receivedRequest
+	release  chan struct{}
+}
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+func newReceiver(t *testing.T, statuses ...int) (*receiver, *httptest.Server) {
+	rcv := &receiver{statuses: statuses, release: make(chan struct{})}
+	server := httptest.NewServer(rcv)
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(rcv.release) }) // runs before Close, which waits for handlers
+	return rcv, server
+}
+
+// hang is a status that makes the receiver never answer.
+const hang = -1
+
+func (rcv *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	rcv.mu.Lock()
+	rcv.requests = append(rcv.requests, receivedRequest{header: r.Header.Clone(), body: body})
+	status := http.StatusOK
+	if len(rcv.statuses) > 0 {
+		status, rcv.statuses = rcv.statuses[0], rcv.statuses[1:]
+	}
+	rcv.mu.Unlock()
+
+	if status == hang {
+		<-rcv.release
+		return
+	}
+	w.WriteHeader(status)
+}
+
+func (rcv *receiver) received() []receivedRequest {
+	rcv.mu.Lock()
+	defer rcv.mu.Unlock()
+	return slices.Clone(rcv.requests)
+}
+
+func newTestWebhookService(t *testing.T, server *httptest.Server, timeout time.Duration) (*WebhookService, *memWebhookRepo, domain.WebhookSubscription) {
+	t.Helper()
+
+	repo := newMemWebhookRepo()
+	sub, err := domain.NewWebhookSubscription(server.URL+"/hooks", []domain.WebhookEventType{domain.WebhookFavouriteAdded}, "whsec_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Registered directly: the service refuses loopback receivers such as the test server.
+	if err := repo.CreateSubscription(context.Background(), &sub); err != nil {
+		t.Fatal(err)
+	}
+
+	client := server.Client()
+	client.Timeout = timeout
+	return NewWebhookService(repo, webhook.NewHTTPSender(client), testBackoff), repo, sub
+}
+
+// publish queues one favourite.added event and returns it.
+func publish(t *testing.T, webhookService *WebhookService) domain.OutboxEvent {
+	t.Helper()
+	event := domain.NewOutboxEvent(domain.EventFavouriteAdded, []byte(`{"user_id":"11111111-1111-1111-1111-111111111111"}`))
+	event.ID = uuid.New()
+	if err := webhookService.Publish(context.Background(), event); err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+	return event
+}
+
+// deliverDue runs one worker round and returns the single delivery's log row.
+func deliverDue(t *testing.T, webhookService *WebhookService, repo *memWebhookRepo, wantAttempted int) domain.WebhookDelivery {
+	t.Helper()
+	n, err := webhookService.DeliverDue(context.Background())
+	if err != nil {
+		t.Fatalf("DeliverDue: %v", err)
+	}
+	if n != wantAttempted {
+		t.Fatalf("DeliverDue attempted %d deliveries, want %d", n, wantAttempted)
+	}
+	deliveries := repo.all()
+	if len(deliveries) != 1 {
+		t.Fatalf("%d deliveries stored, want 1", len(deliveries))
+	}
+	return deliveries[0]
+}
+
+func TestDeliverDueSendsSignedEnvelope(t *testing.T) {
+	rcv, server := newReceiver(t)
+	webhookService, repo, sub := newTestWebhookService(t, server, time.Second)
+
+	event := publish(t, webhookService)
+	d := deliverDue(t, webhookService, repo, 1)
+
+	if d.Status != domain.DeliverySucceeded || d.Attempts != 1 || d.DeliveredAt == nil {
+		t.Fatalf("delivery = %s after %d attempts (delivered at %v), want succeeded after 1", d.Status, d.Attempts, d.DeliveredAt)
+	}
+	if d.LastStatusCode == nil || *d.LastStatusCode != http.StatusOK || d.LastError != "" {
+		t.Fatalf("delivery last result = %v %q, want 200 and no error", d.LastStatusCode, d.LastError)
+	}
+
+	requests := rcv.received()
+	if len(requests) != 1 {
+		t.Fatalf("receiver got %d requests, want 1", len(requests))
+	}
+	req := requests[0]
+
+	var envelope webhookEnvelope
+	if err := json.Unmarshal(req.body, &envelope); err != nil {
+		t.Fatalf("body is not an envelope: %v", err)
+	}
+	if envelope.ID != event.ID || envelope.Type != domain.WebhookFavouriteAdded {
+		t.Fatalf("envelope = %s %s, want %s %s", envelope.ID, envelope.Type, event.ID, domain.WebhookFavouriteAdded)
+	}
+	if h := req.header.Get(webhook.HeaderDelivery); h != d.ID.String() {
+		t.Fatalf("%s = %q, want %s", webhook.HeaderDelivery, h, d.ID)
+	}
+	timestamp, err := strconv.ParseInt(req.header.Get(webhook.HeaderTimestamp), 10, 64)
+	if err != nil {
+		t.Fatalf("%s: %v", webhook.HeaderTimestamp, err)
+	}
+	if h := req.header.Get(webhook.HeaderSignature); h != webhook.Sign(sub.Secret, timestamp, req.body) {
+		t.Fatalf("%s = %q does not sign the body", webhook.HeaderSignature, h)
+	}
+
+	// Done: nothing is due any more.
+	deliverDue(t, webhookService, repo, 0)
+}
+
+func TestDeliverDueRetriesWithBackoff(t *testing.T) {
+	rcv, server := newReceiver(t, http.StatusInternalServerError, hang, http.StatusOK)
+	webhookService, repo, _ := newTestWebhookService(t, server, 100*time.Millisecond)
+	publish(t, webhookService)
+
+	// 5xx: retried after the base backoff.
+	start := time.Now().UTC()
+	d := deliverDue(t, webhookService, repo, 1)
+	if d.Status != domain.DeliveryPending || d.Attempts != 1 {
+		t.Fatalf("after a 500: %s after %d attempts, want pending after 1", d.Status, d.Attempts)
+	}
+	if d.LastStatusCode == nil || *d.LastStatusCode != http.StatusInternalServerError || d.LastError == "" {
+		t.Fatalf("after a 500: last result %v %q", d.LastStatusCode, d.LastError)
+	}
+	assertRetryAfter(t, d, start, testBackoff)
+
+	// Not due yet.
+	deliverDue(t, webhookService, repo, 0)
+
+	// Timeout: no status code, retried after twice the backoff.
+	repo.makeDue()
+	start = time.Now().UTC()
+	d = deliverDue(t, webhookService, repo, 1)
+	if d.Status != domain.DeliveryPending || d.Attempts != 2 {
+		t.Fatalf("after a timeout: %s after %d attempts, want pending after 2", d.Status, d.Attempts)
+	}
+	if d.LastStatusCode != nil || d.LastError == "" {
+		t.Fatalf("after a timeout: last result %v %q, want no status and an error", d.LastStatusCode, d.LastError)
+	}
+	assertRetryAfter(t, d, start, 2*testBackoff)
+
+	// Then the receiver answers.
+	repo.makeDue()
+	d = deliverDue(t, webhookService, repo, 1)
+	if d.Status != domain.DeliverySucceeded || d.Attempts != 3 {
+		t.Fatalf("after a 200: %s after %d attempts, want succeeded after 3", d.Status, d.Attempts)
+	}
+	if n := len(rcv.received()); n != 3 {
+		t.Fatalf("receiver got %d requests, want 3", n)
+	}
+}
+
+func TestDeliverDueGivesUpAfterMaxAttempts(t *testing.T) {
+	statuses := make([]int, domain.MaxWebhookAttempts+1)
+	for i := range statuses {
+		statuses[i] = http.StatusServiceUnavailable
+	}
+	rcv, server := newReceiver(t, statuses...)
+	webhookService, repo, _ := newTestWebhookService(t, server, time.Second)
+	publish(t, webhookService)
+
+	var d domain.WebhookDelivery
+	for attempt := 1; attempt <= domain.MaxWebhookAttempts; attempt++ {
+		repo.makeDue()
+		d = deliverDue(t, webhookService, repo, 1)
+		if d.Attempts != attempt {
+			t.Fatalf("attempts = %d, want %d", d.Attempts, attempt)
+		}
+	}
+	if d.Status != domain.DeliveryFailed || d.DeliveredAt != nil {
+		t.Fatalf("after %d failures: %s, want failed", domain.MaxWebhookAttempts, d.Status)
+	}
+
+	// A failed delivery is never claimed again.
+	repo.makeDue()
+	deliverDue(t, webhookService, repo, 0)
+	if n := len(rcv.received()); n != domain.MaxWebhookAttempts {
+		t.Fatalf("receiver got %d requests, want %d", n, domain.MaxWebhookAttempts)
+	}
+}
+
+func assertRetryAfter(t *testing.T, d domain.WebhookDelivery, attemptedFrom time.Time, delay time.Duration) {
+	t.Helper()
+	earliest := attemptedFrom.Add(delay)
+	latest := time.Now().UTC().Add(delay)
+	if d.NextAttemptAt.Before(earliest) || d.NextAttemptAt.After(latest) {
+		t.Fatalf("next attempt at %v, want %v after the attempt (between %v and %v)", d.NextAttemptAt, delay, earliest, latest)
+	}
+}
+
+// memWebhookRepo is an in-memory ports.WebhookRepository.
+type memWebhookRepo struct {
+	mu         sync.Mutex
+	subs       map[uuid.UUID]domain.WebhookSubscription
+	deliveries []domain.WebhookDelivery
+}
+
+var _ ports.WebhookRepository = (*memWebhookRepo)(nil)
+
+func newMemWebhookRepo() *memWebhookRepo {
+	return &memWebhookRepo{subs: make(map[uuid.UUID]domain.WebhookSubscription)}
+}
+
+// makeDue moves every pending delivery's next attempt to now, as if the backoff had passed.
+func (repo *memWebhookRepo) makeDue() {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	now := time.Now().UTC()
+	for i := range repo.deliveries {
+		repo.deliveries[i].NextAttemptAt = now
+	}
+}
+
+func (repo *memWebhookRepo) all() []domain.WebhookDelivery {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	return slices.Clone(repo.deliveries)
+}
+
+func (repo *memWebhookRepo) CreateSubscription(_ context.Context, s *domain.WebhookSubscription) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	s.ID = uuid.New()
+	repo.subs[s.ID] = *s
+	return nil
+}
+
+func (repo *memWebhookRepo) GetSubscription(_ context.Context, id uuid.UUID) (*domain.WebhookSubscription, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	s, ok := repo.subs[id]
+	if !ok {
+		return nil, domain.ErrWebhookNotFound
+	}
+	return &s, nil
+}
+
+func (repo *memWebhookRepo) ListSubscriptions(context.Context) ([]domain.WebhookSubscription, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	subs := make([]domain.WebhookSubscription, 0, len(repo.subs))
+	for _, s := range repo.subs {
+		subs = append(subs, s)
+	}
+	return subs, nil
+}
+
+func (repo *memWebhookRepo) ListActiveSubscriptions(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	subs, err := repo.ListSubscriptions(ctx)
+	return slices.DeleteFunc(subs, func(s domain.WebhookSubscription) bool { return !s.Active }), err
+}
+
+func (repo *memWebhookRepo) UpdateSubscription(_ context.Context, s *domain.WebhookSubscription) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if _, ok := repo.subs[s.ID]; !ok {
+		return domain.ErrWebhookNotFound
+	}
+	repo.subs[s.ID] = *s
+	return nil
+}
+
+func (repo *memWebhookRepo) DeleteSubscription(_ context.Context, id uuid.UUID) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	if _, ok := repo.subs[id]; !ok {
+		return domain.ErrWebhookNotFound
+	}
+	delete(repo.subs, id)
+	repo.deliveries = slices.DeleteFunc(repo.deliveries, func(d domain.WebhookDelivery) bool { return d.SubscriptionID == id })
+	return nil
+}
+
+func (repo *memWebhookRepo) CreateDeliveries(_ context.Context, deliveries []domain.WebhookDelivery) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	for i := range deliveries {
+		deliveries[i].ID = uuid.New()
+		repo.deliveries = append(repo.deliveries, deliveries[i])
+	}
+	return nil
+}
+
+func (repo *memWebhookRepo) ClaimDueDeliveries(_ context.Context, now time.Time, lease time.Duration, limit int) ([]domain.WebhookDelivery, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	var claimed []domain.WebhookDelivery
+	for i := range repo.deliveries {
+		d := &repo.deliveries[i]
+		if len(claimed) == limit || d.Status != domain.DeliveryPending || d.NextAttemptAt.After(now) {
+			continue
+		}
+		claimed = append(claimed, *d)
+		d.NextAttemptAt = now.Add(lease)
+	}
+	return claimed, nil
+}
+
+func (repo *memWebhookRepo) UpdateDelivery(_ context.Context, d *domain.WebhookDelivery) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	for i := range repo.deliveries {
+		if repo.deliveries[i].ID == d.ID {
+			repo.deliveries[i] = *d
+			return nil
+		}
+	}
+	return domain.ErrWebhookNotFound
+}
+
+func (repo *memWebhookRepo) ListDeliveriesKeyset(_ context.Context, subscriptionID uuid.UUID, limit int, _ string) ([]domain.WebhookDelivery, *string, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	var out []domain.WebhookDelivery
+	for i := len(repo.deliveries) - 1; i >= 0 && len(out) < limit; i-- {
+		if repo.deliveries[i].SubscriptionID == subscriptionID {
+			out = append(out, repo.deliveries[i])
+		}
+	}
+	return out, nil, nil
+}
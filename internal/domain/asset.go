@@ -1,7 +1,10 @@
 package domain
 
 import (
+	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -15,17 +18,51 @@ const (
 	AssetTypeAudience AssetType = "audience"
 )
 
+// Valid reports whether t is one of the known asset types.
+func (t AssetType) Valid() bool {
+	switch t {
+	case AssetTypeChart, AssetTypeInsight, AssetTypeAudience:
+		return true
+	default:
+		return false
+	}
+}
+
 // Asset is the domain representation of an asset.
 type Asset struct {
 	ID          uuid.UUID
 	Type        AssetType
 	Description string
-	Payload     map[string]any
+	Payload     AssetPayload
+	Version     int // incremented on every persisted change
+	CreatedAt   time.Time
+	ArchivedAt  *time.Time
+}
+
+// NewAsset builds a new asset after validating its type, description and payload.
+// The ID is assigned by the repository on creation.
+func NewAsset(assetType AssetType, description string, payload AssetPayload) (*Asset, error) {
+	if !assetType.Valid() {
+		return nil, ErrInvalidAssetType
+	}
+
+	a := &Asset{Type: assetType}
+	if err := a.EditDescription(description); err != nil {
+		return nil, err
+	}
+	if err := a.ReplacePayload(payload); err != nil {
+		return nil, err
+	}
+
+	return a, nil
 }
 
 // EditDescription updates the asset description.
-// It enforces that the description is not empty.
+// It enforces that the description is not empty and the asset is not archived.
 func (a *Asset) EditDescription(newDesc string) error {
+	if a.IsArchived() {
+		return ErrAssetArchived
+	}
 	newDesc = strings.TrimSpace(newDesc)
 	if newDesc == "" {
 		return ErrEmptyDescription
@@ -33,3 +70,56 @@ func (a *Asset) EditDescription(newDesc string) error {
 	a.Description = newDesc
 	return nil
 }
+
+// CheckVersion enforces the expected versions (optimistic concurrency): the asset must still be at one of them.
+// It returns ErrAssetVersionMismatch when the asset has changed since.
+func (a *Asset) CheckVersion(expected ...int) error {
+	if !slices.Contains(expected, a.Version) {
+		return ErrAssetVersionMismatch
+	}
+	return nil
+}
+
+// IsArchived reports whether the asset has been archived (soft-deleted).
+func (a *Asset) IsArchived() bool {
+	return a.ArchivedAt != nil
+}
+
+// Archive marks the asset as archived and reports whether it was active. Archiving twice keeps the first timestamp.
+func (a *Asset) Archive() bool {
+	if a.IsArchived() {
+		return false
+	}
+	now := time.Now().UTC()
+	a.ArchivedAt = &now
+	return true
+}
+
+// Restore brings an archived asset back. It reports whether the asset was archived.
+func (a *Asset) Restore() bool {
+	if !a.IsArchived() {
+		return false
+	}
+	a.ArchivedAt = nil
+	return true
+}
+
+// ReplacePayload swaps the asset payload.
+// It enforces that the payload matches the asset type and is valid,
+// and that the asset is not archived.
+func (a *Asset) ReplacePayload(payload AssetPayload) error {
+	if a.IsArchived() {
+		return ErrAssetArchived
+	}
+	if payload == nil {
+		return fmt.Errorf("%w: payload is required", ErrInvalidPayload)
+	}
+	if payload.AssetType() != a.Type {
+		return fmt.Errorf("%w: %s payload given for %s asset", ErrInvalidPayload, payload.AssetType(), a.Type)
+	}
+	if err := payload.Validate(); err != nil {
+		return err
+	}
+	a.Payload = payload
+	return nil
+}
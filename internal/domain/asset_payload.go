@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AssetPayload is the typed content of an asset.
+// Every AssetType has its own payload struct.
+type AssetPayload interface {
+	// AssetType returns the asset type the payload belongs to.
+	AssetType() AssetType
+
+	// Validate checks the payload shape and returns ErrInvalidPayload on failure.
+	Validate() error
+}
+
+// ChartPayload holds a chart: a title plus either X/Y points
+// or Series/Values pairs (both of equal length).
+type ChartPayload struct {
+	Title  string
+	X      []string
+	Y      []float64
+	Series []string
+	Values []float64
+	YLabel string
+}
+
+func (ChartPayload) AssetType() AssetType { return AssetTypeChart }
+
+// Validate requires a title and one complete, equally sized data set.
+func (p ChartPayload) Validate() error {
+	if strings.TrimSpace(p.Title) == "" {
+		return fmt.Errorf("%w: chart requires a title", ErrInvalidPayload)
+	}
+
+	hasXY := len(p.X) > 0 || len(p.Y) > 0
+	hasSeries := len(p.Series) > 0 || len(p.Values) > 0
+	switch {
+	case hasXY && hasSeries:
+		return fmt.Errorf("%w: chart takes either x/y or series/values, not both", ErrInvalidPayload)
+	case hasXY:
+		return validatePairs("x", "y", len(p.X), len(p.Y))
+	case hasSeries:
+		return validatePairs("series", "values", len(p.Series), len(p.Values))
+	default:
+		return fmt.Errorf("%w: chart requires x/y or series/values", ErrInvalidPayload)
+	}
+}
+
+// InsightPayload holds a short textual insight.
+type InsightPayload struct {
+	Text string
+}
+
+func (InsightPayload) AssetType() AssetType { return AssetTypeInsight }
+
+// Validate requires a non-empty text.
+func (p InsightPayload) Validate() error {
+	if strings.TrimSpace(p.Text) == "" {
+		return fmt.Errorf("%w: insight requires a text", ErrInvalidPayload)
+	}
+	return nil
+}
+
+// AudiencePayload describes an audience by demographic characteristics.
+type AudiencePayload struct {
+	Gender         string
+	AgeGroup       string
+	Country        string
+	SocialHours    string
+	PurchasesMonth string
+}
+
+func (AudiencePayload) AssetType() AssetType { return AssetTypeAudience }
+
+// Validate requires at least one demographic characteristic.
+func (p AudiencePayload) Validate() error {
+	for _, v := range []string{p.Gender, p.AgeGroup, p.Country, p.SocialHours, p.PurchasesMonth} {
+		if strings.TrimSpace(v) != "" {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: audience requires at least one of gender, age_group, country, social_hours, purchases_month", ErrInvalidPayload)
+}
+
+// validatePairs checks that a label/value data set is non-empty and aligned.
+func validatePairs(labelsKey, valuesKey string, labels, values int) error {
+	if labels == 0 || values == 0 {
+		return fmt.Errorf("%w: chart requires both %s and %s", ErrInvalidPayload, labelsKey, valuesKey)
+	}
+	if labels != values {
+		return fmt.Errorf("%w: chart %s and %s must have the same length", ErrInvalidPayload, labelsKey, valuesKey)
+	}
+	return nil
+}
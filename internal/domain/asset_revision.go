@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AssetRevision records a single description edit of an asset.
+type AssetRevision struct {
+	ID             uuid.UUID
+	AssetID        uuid.UUID
+	OldDescription string
+	NewDescription string
+	EditorID       *uuid.UUID // nil when the editor is unknown
+	CreatedAt      time.Time
+}
+
+// NewAssetRevision creates a revision for an edit with a timestamp.
+func NewAssetRevision(assetID uuid.UUID, oldDesc, newDesc string, editorID *uuid.UUID) AssetRevision {
+	return AssetRevision{
+		AssetID:        assetID,
+		OldDescription: oldDesc,
+		NewDescription: newDesc,
+		EditorID:       editorID,
+		CreatedAt:      time.Now().UTC(),
+	}
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewAsset(t *testing.T) {
+	tests := []struct {
+		name        string
+		assetType   AssetType
+		description string
+		payload     AssetPayload
+		wantErr     error
+	}{
+		{name: "chart x/y", assetType: AssetTypeChart, description: "DAU", payload: ChartPayload{Title: "DAU", X: []string{"Mon", "Tue"}, Y: []float64{1, 2}}},
+		{name: "chart series", assetType: AssetTypeChart, description: "Channels", payload: ChartPayload{Title: "Channels", Series: []string{"Email"}, Values: []float64{2.1}}},
+		{name: "insight", assetType: AssetTypeInsight, description: "Social", payload: InsightPayload{Text: "40% of millennials"}},
+		{name: "audience", assetType: AssetTypeAudience, description: "Males", payload: AudiencePayload{Gender: "Male"}},
+		{name: "unknown type", assetType: "table", description: "Table", payload: InsightPayload{Text: "x"}, wantErr: ErrInvalidAssetType},
+		{name: "blank description", assetType: AssetTypeInsight, description: "  ", payload: InsightPayload{Text: "x"}, wantErr: ErrEmptyDescription},
+		{name: "no payload", assetType: AssetTypeInsight, description: "Social", wantErr: ErrInvalidPayload},
+		{name: "payload of another type", assetType: AssetTypeChart, description: "DAU", payload: InsightPayload{Text: "x"}, wantErr: ErrInvalidPayload},
+		{name: "chart without title", assetType: AssetTypeChart, description: "DAU", payload: ChartPayload{X: []string{"Mon"}, Y: []float64{1}}, wantErr: ErrInvalidPayload},
+		{name: "chart of unequal length", assetType: AssetTypeChart, description: "DAU", payload: ChartPayload{Title: "DAU", X: []string{"Mon", "Tue"}, Y: []float64{1}}, wantErr: ErrInvalidPayload},
+		{name: "chart with both data sets", assetType: AssetTypeChart, description: "DAU", payload: ChartPayload{Title: "DAU", X: []string{"Mon"}, Y: []float64{1}, Series: []string{"Email"}, Values: []float64{2}}, wantErr: ErrInvalidPayload},
+		{name: "blank insight", assetType: AssetTypeInsight, description: "Social", payload: InsightPayload{Text: " "}, wantErr: ErrInvalidPayload},
+		{name: "audience without characteristics", assetType: AssetTypeAudience, description: "Males", payload: AudiencePayload{}, wantErr: ErrInvalidPayload},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := NewAsset(tt.assetType, tt.description, tt.payload)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewAsset() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && (a.Type != tt.assetType || a.Description != tt.description) {
+				t.Fatalf("NewAsset() = %+v", a)
+			}
+		})
+	}
+}
+
+func TestArchiveAndRestore(t *testing.T) {
+	a, err := NewAsset(AssetTypeInsight, "Social", InsightPayload{Text: "40% of millennials"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !a.Archive() || !a.IsArchived() {
+		t.Fatal("Archive() of an active asset did not archive it")
+	}
+	archivedAt := *a.ArchivedAt
+	if a.Archive() || *a.ArchivedAt != archivedAt {
+		t.Fatal("Archive() of an archived asset changed it")
+	}
+	if err := a.EditDescription("Edited"); !errors.Is(err, ErrAssetArchived) {
+		t.Fatalf("EditDescription() of an archived asset error = %v, want %v", err, ErrAssetArchived)
+	}
+	if err := a.ReplacePayload(InsightPayload{Text: "Edited"}); !errors.Is(err, ErrAssetArchived) {
+		t.Fatalf("ReplacePayload() of an archived asset error = %v, want %v", err, ErrAssetArchived)
+	}
+
+	if !a.Restore() || a.IsArchived() {
+		t.Fatal("Restore() of an archived asset did not restore it")
+	}
+	if a.Restore() {
+		t.Fatal("Restore() of an active asset changed it")
+	}
+	if err := a.EditDescription("Edited"); err != nil {
+		t.Fatalf("EditDescription() of a restored asset error = %v", err)
+	}
+}
@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Audited entity types.
+const (
+	AuditEntityAsset     = "asset"
+	AuditEntityFavourite = "favourite"
+)
+
+// AuditAction is what happened to an audited entity, as "<entity type>.<verb>".
+type AuditAction string
+
+const (
+	AuditAssetCreated     AuditAction = "asset.created"
+	AuditAssetUpdated     AuditAction = "asset.updated"
+	AuditAssetDeleted     AuditAction = "asset.deleted"
+	AuditFavouriteCreated AuditAction = "favourite.created"
+	AuditFavouriteUpdated AuditAction = "favourite.updated"
+	AuditFavouriteDeleted AuditAction = "favourite.deleted"
+)
+
+// Valid reports whether a is one of the recorded actions.
+func (a AuditAction) Valid() bool {
+	switch a {
+	case AuditAssetCreated, AuditAssetUpdated, AuditAssetDeleted,
+		AuditFavouriteCreated, AuditFavouriteUpdated, AuditFavouriteDeleted:
+		return true
+	}
+	return false
+}
+
+// AuditEntry records one change to an asset or favourite.
+type AuditEntry struct {
+	ID             uuid.UUID
+	At             time.Time
+	ClaimedActorID *uuid.UUID // the unverified X-User-ID of the request; nil when not given
+	RequestID      string     // empty for changes made outside a request
+	Action         AuditAction
+	EntityType     string
+	EntityID       uuid.UUID
+	AssetID        *uuid.UUID
+	UserID         *uuid.UUID     // set for favourites
+	Before         map[string]any // nil for creations
+	After          map[string]any // nil for deletions
+}
+
+// AuditFilter narrows an audit listing; zero fields don't filter.
+type AuditFilter struct {
+	ClaimedActorID *uuid.UUID
+	AssetID        *uuid.UUID
+	UserID         *uuid.UUID
+	Action         AuditAction
+	RequestID      string
+	From           *time.Time // at or after
+	To             *time.Time // before
+}
+
+// AuditContext identifies who claims to be changing data and in which request.
+type AuditContext struct {
+	ClaimedActorID *uuid.UUID
+	RequestID      string
+}
+
+type auditContextKey struct{}
+
+// WithAuditContext returns ctx carrying ac, for the audit entries of the changes made with it.
+func WithAuditContext(ctx context.Context, ac AuditContext) context.Context {
+	return context.WithValue(ctx, auditContextKey{}, ac)
+}
+
+// AuditContextFrom returns the AuditContext carried by ctx, or the zero value when there is none.
+func AuditContextFrom(ctx context.Context) AuditContext {
+	ac, _ := ctx.Value(auditContextKey{}).(AuditContext)
+	return ac
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// MaxCollectionNameLength is the maximum number of characters of a collection name.
+const MaxCollectionNameLength = 100
+
+// Collection is a user-owned folder grouping some of the user's favourites.
+type Collection struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Name      string
+	CreatedAt time.Time
+}
+
+// NewCollection creates a new collection for userID with a timestamp.
+func NewCollection(userID uuid.UUID, name string) (Collection, error) {
+	c := Collection{
+		UserID:    userID,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := c.Rename(name); err != nil {
+		return Collection{}, err
+	}
+	return c, nil
+}
+
+// Rename updates the collection name.
+// It enforces that the name is not empty and at most MaxCollectionNameLength characters.
+func (c *Collection) Rename(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ErrInvalidCollectionName
+	}
+	if utf8.RuneCountInString(name) > MaxCollectionNameLength {
+		return ErrInvalidCollectionName
+	}
+	c.Name = name
+	return nil
+}
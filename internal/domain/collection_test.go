@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestRenameCountsCharacters(t *testing.T) {
+	tests := []struct {
+		name    string
+		newName string
+		wantErr error
+	}{
+		{name: "ascii at limit", newName: strings.Repeat("a", MaxCollectionNameLength)},
+		{name: "ascii over limit", newName: strings.Repeat("a", MaxCollectionNameLength+1), wantErr: ErrInvalidCollectionName},
+		{name: "greek at limit", newName: strings.Repeat("λ", MaxCollectionNameLength)},
+		{name: "emoji at limit", newName: strings.Repeat("⭐", MaxCollectionNameLength)},
+		{name: "emoji over limit", newName: strings.Repeat("⭐", MaxCollectionNameLength+1), wantErr: ErrInvalidCollectionName},
+		{name: "blank", newName: "   ", wantErr: ErrInvalidCollectionName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewCollection(uuid.New(), tt.newName)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewCollection() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -11,6 +11,14 @@ var (
 	ErrAssetNotFound    = errors.New("asset not found")
 	ErrInvalidAssetType = errors.New("invalid asset type")
 	ErrEmptyDescription = errors.New("asset description cannot be empty")
+	ErrInvalidPayload   = errors.New("invalid asset payload")
+	ErrInvalidTimeRange = errors.New("invalid time range")
+	ErrAssetArchived    = errors.New("asset is archived")
+	ErrRevisionNotFound = errors.New("asset revision not found")
+	ErrInvalidPatch     = errors.New("invalid patch document")
+	ErrPatchConflict    = errors.New("patch cannot be applied")
+
+	ErrAssetVersionMismatch = errors.New("asset version mismatch")
 
 	// User errors
 	ErrUserNotFound = errors.New("user not found")
@@ -18,5 +26,26 @@ var (
 	// Favourite errors
 	ErrFavouriteNotFound      = errors.New("favourite not found")
 	ErrFavouriteAlreadyExists = errors.New("favourite already exists")
+	ErrSyncTokenExpired       = errors.New("sync token expired")
 	ErrBadCursor              = errors.New("bad cursor")
+	ErrNoteTooLong            = errors.New("favourite note is too long")
+	ErrInvalidSort            = errors.New("invalid sort")
+	ErrInvalidWindow          = errors.New("invalid trending window")
+	ErrEmptyBatch             = errors.New("batch is empty")
+	ErrTooManyImportRows      = errors.New("too many import rows")
+	ErrBatchTooLarge          = errors.New("batch is too large")
+	ErrEventsClosed           = errors.New("favourite events are closed")
+
+	// Collection errors
+	ErrCollectionNotFound      = errors.New("collection not found")
+	ErrCollectionAlreadyExists = errors.New("collection already exists")
+	ErrInvalidCollectionName   = errors.New("invalid collection name")
+
+	// Webhook errors
+	ErrWebhookNotFound      = errors.New("webhook subscription not found")
+	ErrInvalidWebhookURL    = errors.New("invalid webhook url")
+	ErrInvalidWebhookEvents = errors.New("invalid webhook event types")
+
+	// Audit errors
+	ErrInvalidAuditFilter = errors.New("invalid audit filter")
 )
@@ -1,24 +1,162 @@
 package domain
 
 import (
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 )
 
+// MaxNoteLength is the maximum number of characters of a favourite note.
+const MaxNoteLength = 500
+
+// MaxBatchSize is the maximum number of assets in a bulk favourite operation.
+const MaxBatchSize = 100
+
+// MaxImportRows is the maximum number of rows in a favourites import.
+const MaxImportRows = 1000
+
+// FavouriteSort is the ordering of a user's favourites listing.
+type FavouriteSort string
+
+const (
+	SortCreatedAsc  FavouriteSort = "created_asc"  // oldest favourite first (default)
+	SortCreatedDesc FavouriteSort = "created_desc" // newest favourite first
+	SortDescription FavouriteSort = "description"  // by asset description, A to Z
+)
+
+func (s FavouriteSort) Valid() bool {
+	switch s {
+	case SortCreatedAsc, SortCreatedDesc, SortDescription:
+		return true
+	default:
+		return false
+	}
+}
+
+// FavouriteOutcome is the per-asset result of a bulk favourite operation.
+type FavouriteOutcome string
+
+const (
+	FavouriteCreated       FavouriteOutcome = "created"
+	FavouriteAlreadyExists FavouriteOutcome = "already_exists"
+	FavouriteAssetNotFound FavouriteOutcome = "asset_not_found"
+	FavouriteAssetArchived FavouriteOutcome = "asset_archived"
+	FavouriteRemoved       FavouriteOutcome = "removed"
+	FavouriteNotFound      FavouriteOutcome = "not_found"
+	FavouriteInvalid       FavouriteOutcome = "invalid"
+	FavouriteFailed        FavouriteOutcome = "failed" // an unexpected error, the row may be retried
+)
+
+// FavouriteResult pairs an asset of a bulk operation with its outcome.
+type FavouriteResult struct {
+	AssetID uuid.UUID
+	Outcome FavouriteOutcome
+}
+
 // Favourite represents "user favourites an asset".
 type Favourite struct {
 	ID        uuid.UUID
 	UserID    uuid.UUID
 	AssetID   uuid.UUID
+	Note      string // private note / custom title, may be empty
 	CreatedAt time.Time
 }
 
+// FavouritedAsset pairs a favourite with the asset it points to.
+type FavouritedAsset struct {
+	Favourite Favourite
+	Asset     Asset
+}
+
 // NewFavourite creates a new favourite with a timestamp.
-func NewFavourite(userID, assetID uuid.UUID) Favourite {
-	return Favourite{
+// It enforces the note length limit.
+func NewFavourite(userID, assetID uuid.UUID, note string) (Favourite, error) {
+	f := Favourite{
 		UserID:    userID,
 		AssetID:   assetID,
 		CreatedAt: time.Now().UTC(),
 	}
+	if err := f.EditNote(note); err != nil {
+		return Favourite{}, err
+	}
+	return f, nil
+}
+
+// ChangeKind tells whether a favourite was added, removed or had its note edited.
+type ChangeKind string
+
+const (
+	ChangeAdded   ChangeKind = "added"
+	ChangeRemoved ChangeKind = "removed"
+	// ChangeNoteEdited only appears in live events; the change feed keeps no history of note edits.
+	ChangeNoteEdited ChangeKind = "note_edited"
+)
+
+// FavouriteChange is one entry of a user's favourites change feed. Favourite is set for additions.
+type FavouriteChange struct {
+	Kind      ChangeKind
+	AssetID   uuid.UUID
+	At        time.Time
+	Favourite *FavouritedAsset
+}
+
+// FavouriteEvent is a live notification about a change to one of a user's favourites.
+// ID is assigned when the event is published and increases monotonically.
+// Favourite is set for additions and note edits.
+type FavouriteEvent struct {
+	ID        uint64
+	UserID    uuid.UUID
+	Kind      ChangeKind
+	AssetID   uuid.UUID
+	At        time.Time
+	Favourite *Favourite
+}
+
+// ImportRow is one favourite of an import file, as read from it.
+type ImportRow struct {
+	AssetID string
+	Note    string
+}
+
+// ImportResult is the outcome of one import row (1-based); Reason explains invalid rows.
+type ImportResult struct {
+	Row     int
+	AssetID string
+	Outcome FavouriteOutcome
+	Reason  string
+}
+
+// ValidateBatch checks the size of a bulk operation and drops duplicate asset IDs,
+// keeping the first occurrence order.
+func ValidateBatch(assetIDs []uuid.UUID) ([]uuid.UUID, error) {
+	if len(assetIDs) == 0 {
+		return nil, ErrEmptyBatch
+	}
+	if len(assetIDs) > MaxBatchSize {
+		return nil, ErrBatchTooLarge
+	}
+
+	seen := make(map[uuid.UUID]struct{}, len(assetIDs))
+	unique := make([]uuid.UUID, 0, len(assetIDs))
+	for _, id := range assetIDs {
+		if _, dup := seen[id]; dup {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	return unique, nil
+}
+
+// EditNote updates the favourite note. An empty note clears it.
+// It enforces that the note is at most MaxNoteLength characters.
+func (f *Favourite) EditNote(note string) error {
+	note = strings.TrimSpace(note)
+	if utf8.RuneCountInString(note) > MaxNoteLength {
+		return ErrNoteTooLong
+	}
+	f.Note = note
+	return nil
 }
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestEditNoteCountsCharacters(t *testing.T) {
+	tests := []struct {
+		name    string
+		note    string
+		wantErr error
+	}{
+		{name: "ascii at limit", note: strings.Repeat("a", MaxNoteLength)},
+		{name: "ascii over limit", note: strings.Repeat("a", MaxNoteLength+1), wantErr: ErrNoteTooLong},
+		{name: "greek at limit", note: strings.Repeat("λ", MaxNoteLength)},
+		{name: "emoji at limit", note: strings.Repeat("⭐", MaxNoteLength)},
+		{name: "emoji over limit", note: strings.Repeat("⭐", MaxNoteLength+1), wantErr: ErrNoteTooLong},
+		{name: "surrounding spaces trimmed", note: "  " + strings.Repeat("λ", MaxNoteLength) + "  "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewFavourite(uuid.New(), uuid.New(), tt.note)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewFavourite() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
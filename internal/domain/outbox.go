@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxOutboxBackoff caps the delay before an event that failed to publish is retried.
+const MaxOutboxBackoff = 5 * time.Minute
+
+// EventType names a domain event. Webhook event types use the same names.
+type EventType string
+
+const (
+	EventFavouriteAdded         EventType = "favourite.added"
+	EventFavouriteRemoved       EventType = "favourite.removed"
+	EventFavouriteNoteEdited    EventType = "favourite.note_edited"
+	EventAssetDescriptionEdited EventType = "asset.description_edited"
+)
+
+// OutboxEvent is a domain event recorded in the same transaction as the change that raised it,
+// so it exists exactly when the change was committed. A relay publishes it afterwards, at least once.
+type OutboxEvent struct {
+	ID          uuid.UUID
+	Type        EventType
+	Payload     []byte // JSON event data
+	CreatedAt   time.Time
+	AvailableAt time.Time // when the relay may next try to publish it
+	Attempts    int       // failed publish attempts
+	LastError   string
+	PublishedTo []string // publishers that accepted it, skipped on retries
+	PublishedAt *time.Time
+}
+
+// NewOutboxEvent records an event that is ready to publish right away.
+func NewOutboxEvent(eventType EventType, payload []byte) OutboxEvent {
+	now := time.Now().UTC()
+	return OutboxEvent{
+		Type:        eventType,
+		Payload:     payload,
+		CreatedAt:   now,
+		AvailableAt: now,
+	}
+}
+
+// RecordFailure notes a failed publish attempt at now and delays the next one: base, doubled per
+// failed attempt, capped at MaxOutboxBackoff. Events are never given up.
+func (e *OutboxEvent) RecordFailure(now time.Time, err error, base time.Duration) {
+	e.Attempts++
+	e.LastError = err.Error()
+
+	delay := base
+	for i := 1; i < e.Attempts && delay < MaxOutboxBackoff; i++ {
+		delay *= 2
+	}
+	e.AvailableAt = now.Add(min(delay, MaxOutboxBackoff))
+}
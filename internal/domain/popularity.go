@@ -0,0 +1,24 @@
+package domain
+
+import "time"
+
+// Trending windows: the default and the longest one accepted.
+const (
+	DefaultTrendingWindow = 7 * 24 * time.Hour
+	MaxTrendingWindow     = 90 * 24 * time.Hour
+)
+
+// PopularAsset is an asset together with how many times it was favourited
+// (all time, or within a trending window).
+type PopularAsset struct {
+	Asset      Asset
+	Favourites int
+}
+
+// TrendingSince returns the start of a trending window ending at now, or ErrInvalidWindow.
+func TrendingSince(now time.Time, window time.Duration) (time.Time, error) {
+	if window <= 0 || window > MaxTrendingWindow {
+		return time.Time{}, ErrInvalidWindow
+	}
+	return now.Add(-window), nil
+}
@@ -0,0 +1,25 @@
+package domain
+
+import "github.com/google/uuid"
+
+// FeedPosition is a place in the feed of all users' favourite changes, which is ordered by the
+// transaction that made each change and then by row ID. The zero position is the beginning.
+type FeedPosition struct {
+	Tx int64
+	ID uuid.UUID
+}
+
+// FavouriteDelta is one entry of the feed of all users' favourite changes: Kind is ChangeAdded
+// or ChangeRemoved.
+type FavouriteDelta struct {
+	Kind    ChangeKind
+	UserID  uuid.UUID
+	AssetID uuid.UUID
+}
+
+// RecommendedAsset is an asset suggested from favourite co-occurrence. Score counts the users
+// who favourited it together with the seed asset(s).
+type RecommendedAsset struct {
+	Asset Asset
+	Score int
+}
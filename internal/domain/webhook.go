@@ -0,0 +1,180 @@
+package domain
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxWebhookURLLength is the maximum length of a webhook receiver URL.
+const MaxWebhookURLLength = 2048
+
+// MaxWebhookAttempts is how many times a delivery is attempted before it is given up.
+const MaxWebhookAttempts = 8
+
+// MaxWebhookBackoff caps the delay between two delivery attempts.
+const MaxWebhookBackoff = time.Hour
+
+// WebhookEventType names an event that webhooks are sent for.
+type WebhookEventType string
+
+const (
+	WebhookFavouriteAdded         WebhookEventType = "favourite.added"
+	WebhookFavouriteRemoved       WebhookEventType = "favourite.removed"
+	WebhookAssetDescriptionEdited WebhookEventType = "asset.description_edited"
+	// WebhookPing is only sent on request, to check a receiver; it cannot be subscribed to.
+	WebhookPing WebhookEventType = "ping"
+)
+
+// Valid reports whether subscriptions may ask for the event type.
+func (t WebhookEventType) Valid() bool {
+	switch t {
+	case WebhookFavouriteAdded, WebhookFavouriteRemoved, WebhookAssetDescriptionEdited:
+		return true
+	default:
+		return false
+	}
+}
+
+// WebhookSubscription is a receiver URL that gets the events it subscribed to, signed with Secret.
+type WebhookSubscription struct {
+	ID         uuid.UUID
+	URL        string
+	Secret     string
+	EventTypes []WebhookEventType
+	Active     bool // inactive subscriptions get no new deliveries
+	CreatedAt  time.Time
+}
+
+// NewWebhookSubscription creates an active subscription after validating its URL and event types.
+func NewWebhookSubscription(rawURL string, eventTypes []WebhookEventType, secret string) (WebhookSubscription, error) {
+	s := WebhookSubscription{
+		Secret:    secret,
+		Active:    true,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := s.SetURL(rawURL); err != nil {
+		return WebhookSubscription{}, err
+	}
+	if err := s.SetEventTypes(eventTypes); err != nil {
+		return WebhookSubscription{}, err
+	}
+	return s, nil
+}
+
+// SetURL changes the receiver URL. It must be an absolute http(s) URL of at most MaxWebhookURLLength.
+func (s *WebhookSubscription) SetURL(rawURL string) error {
+	if len(rawURL) > MaxWebhookURLLength {
+		return fmt.Errorf("%w: longer than %d characters", ErrInvalidWebhookURL, MaxWebhookURLLength)
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: must be an absolute http or https URL", ErrInvalidWebhookURL)
+	}
+	s.URL = rawURL
+	return nil
+}
+
+// SetEventTypes replaces the subscribed event types; there must be at least one and duplicates are collapsed.
+func (s *WebhookSubscription) SetEventTypes(eventTypes []WebhookEventType) error {
+	if len(eventTypes) == 0 {
+		return fmt.Errorf("%w: at least one event type is required", ErrInvalidWebhookEvents)
+	}
+	out := make([]WebhookEventType, 0, len(eventTypes))
+	for _, t := range eventTypes {
+		if !t.Valid() {
+			return fmt.Errorf("%w: unknown event type %q", ErrInvalidWebhookEvents, t)
+		}
+		if !slices.Contains(out, t) {
+			out = append(out, t)
+		}
+	}
+	s.EventTypes = out
+	return nil
+}
+
+// Wants reports whether the subscription should get events of type t. Pings always go through.
+func (s WebhookSubscription) Wants(t WebhookEventType) bool {
+	return s.Active && (t == WebhookPing || slices.Contains(s.EventTypes, t))
+}
+
+// DeliveryStatus is the state of a webhook delivery.
+type DeliveryStatus string
+
+const (
+	DeliveryPending   DeliveryStatus = "pending"   // waiting for its next attempt
+	DeliverySucceeded DeliveryStatus = "succeeded" // the receiver answered 2xx
+	DeliveryFailed    DeliveryStatus = "failed"    // given up after MaxWebhookAttempts
+)
+
+// WebhookDelivery is one event queued for one subscription.
+type WebhookDelivery struct {
+	ID             uuid.UUID
+	SubscriptionID uuid.UUID
+	EventID        uuid.UUID
+	EventType      WebhookEventType
+	Payload        []byte // exact JSON body that is posted and signed
+	Status         DeliveryStatus
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastStatusCode *int
+	LastError      string
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+}
+
+// NewWebhookDelivery queues an event payload for a subscription, due right away.
+func NewWebhookDelivery(subscriptionID, eventID uuid.UUID, eventType WebhookEventType, payload []byte) WebhookDelivery {
+	now := time.Now().UTC()
+	return WebhookDelivery{
+		SubscriptionID: subscriptionID,
+		EventID:        eventID,
+		EventType:      eventType,
+		Payload:        payload,
+		Status:         DeliveryPending,
+		NextAttemptAt:  now,
+		CreatedAt:      now,
+	}
+}
+
+// RecordAttempt applies the result of an attempt made at now. A 2xx status completes the delivery;
+// anything else (statusCode is 0 when the request failed) schedules a retry after an exponential
+// backoff starting at base, until MaxWebhookAttempts is reached and the delivery fails.
+func (d *WebhookDelivery) RecordAttempt(now time.Time, statusCode int, attemptErr error, base time.Duration) {
+	d.Attempts++
+	d.LastStatusCode = nil
+	if statusCode != 0 {
+		d.LastStatusCode = &statusCode
+	}
+
+	switch {
+	case attemptErr == nil && statusCode >= 200 && statusCode < 300:
+		d.Status = DeliverySucceeded
+		d.LastError = ""
+		d.DeliveredAt = &now
+		return
+	case attemptErr != nil:
+		d.LastError = attemptErr.Error()
+	default:
+		d.LastError = fmt.Sprintf("receiver answered %d", statusCode)
+	}
+
+	if d.Attempts >= MaxWebhookAttempts {
+		d.Status = DeliveryFailed
+		return
+	}
+	d.NextAttemptAt = now.Add(WebhookBackoff(base, d.Attempts))
+}
+
+// WebhookBackoff is the delay after the given failed attempt (1-based): base doubled per attempt,
+// capped at MaxWebhookBackoff.
+func WebhookBackoff(base time.Duration, attempt int) time.Duration {
+	delay := base
+	for i := 1; i < attempt && delay < MaxWebhookBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, MaxWebhookBackoff)
+}
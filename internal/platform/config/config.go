@@ -2,6 +2,7 @@ package config
 
 import (
 	"os"
+	"time"
 )
 
 // Config holds the minimal app settings.
@@ -18,6 +19,24 @@ type Config struct {
 
 	LogLevel string
 	LogPath  string
+
+	CursorSecret string        // HMAC key for pagination cursors; random per process when empty
+	CursorTTL    time.Duration // how long a pagination cursor stays valid
+
+	RecommenderRefresh time.Duration // how often new favourites are folded into the recommender
+	RecommenderRebuild time.Duration // how often the recommender is rebuilt from scratch
+
+	SyncRetention time.Duration // how long favourite removals (and sync tokens) are kept for the change feed
+
+	WebhookPoll    time.Duration // how often due webhook deliveries are looked for
+	WebhookTimeout time.Duration // how long a webhook receiver gets to answer
+	WebhookBackoff time.Duration // delay before the first webhook retry, doubled per attempt
+
+	OutboxPoll      time.Duration // how often the outbox relay looks for unpublished events
+	OutboxBackoff   time.Duration // delay before an event that failed to publish is retried, doubled per attempt
+	OutboxRetention time.Duration // how long published outbox events are kept
+
+	AdminToken string // bearer token of admin endpoints (audit log, runtime metrics); they are disabled when empty
 }
 
 // LoadFromEnv builds a Config by reading environment variables.
@@ -36,6 +55,24 @@ func LoadFromEnv() *Config {
 
 		LogLevel: getEnvOrFallback("LOG_LEVEL", "info"),
 		LogPath:  getEnvOrFallback("LOG_PATH", "./logs/"),
+
+		CursorSecret: getEnvOrFallback("CURSOR_SECRET", ""),
+		CursorTTL:    getDurationOrFallback("CURSOR_TTL", 24*time.Hour),
+
+		RecommenderRefresh: getDurationOrFallback("RECOMMENDER_REFRESH", time.Minute),
+		RecommenderRebuild: getDurationOrFallback("RECOMMENDER_REBUILD", time.Hour),
+
+		SyncRetention: getDurationOrFallback("SYNC_RETENTION", 30*24*time.Hour),
+
+		WebhookPoll:    getDurationOrFallback("WEBHOOK_POLL", 5*time.Second),
+		WebhookTimeout: getDurationOrFallback("WEBHOOK_TIMEOUT", 10*time.Second),
+		WebhookBackoff: getDurationOrFallback("WEBHOOK_BACKOFF", 30*time.Second),
+
+		OutboxPoll:      getDurationOrFallback("OUTBOX_POLL", time.Second),
+		OutboxBackoff:   getDurationOrFallback("OUTBOX_BACKOFF", 5*time.Second),
+		OutboxRetention: getDurationOrFallback("OUTBOX_RETENTION", 7*24*time.Hour),
+
+		AdminToken: getEnvOrFallback("ADMIN_TOKEN", ""),
 	}
 }
 
@@ -47,3 +84,13 @@ func getEnvOrFallback(key, fallback string) string {
 	}
 	return fallback
 }
+
+// getDurationOrFallback parses the environment variable as a time.Duration (e.g. "30m"),
+// falling back when it is unset or invalid.
+func getDurationOrFallback(key string, fallback time.Duration) time.Duration {
+	d, err := time.ParseDuration(getEnvOrFallback(key, ""))
+	if err != nil || d <= 0 {
+		return fallback
+	}
+	return d
+}
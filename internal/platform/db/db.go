@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
 	"github.com/SokratisChaimanas/platform-go-challenge/ent"
+	"github.com/SokratisChaimanas/platform-go-challenge/ent/schema"
 	"github.com/SokratisChaimanas/platform-go-challenge/internal/platform/config"
 	_ "github.com/jackc/pgx/v5/stdlib"
 )
@@ -27,6 +28,11 @@ func NewClient(ctx context.Context, cfg *config.Config) (*ent.Client, error) {
 		return nil, fmt.Errorf("running schema migration: %w", err)
 	}
 
+	// Indexes ent cannot describe (expression indexes) are applied here.
+	if _, err := db.ExecContext(ctx, schema.AssetDescriptionSearchIndex); err != nil {
+		return nil, fmt.Errorf("creating asset search index: %w", err)
+	}
+
 	if err := seedDevOnce(ctx, client, cfg.AppEnv); err != nil {
 		return nil, fmt.Errorf("seeding dev data: %w", err)
 	}
@@ -2,16 +2,46 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
 	"github.com/google/uuid"
 )
 
+// AssetFilter narrows down catalogue listings. Zero values mean "no filter".
+type AssetFilter struct {
+	Type        domain.AssetType // exact asset type
+	CreatedFrom *time.Time       // created_at >= CreatedFrom
+	CreatedTo   *time.Time       // created_at < CreatedTo
+	Query       string           // full-text search over description
+}
+
 // AssetRepository stores and retrieves assets.
 type AssetRepository interface {
 	// Get returns the asset or domain.ErrAssetNotFound.
 	Get(ctx context.Context, id uuid.UUID) (*domain.Asset, error)
 
-	// Update persists changes to an asset.
+	// GetMany returns the assets among ids in one query (archived included); missing ones are absent.
+	GetMany(ctx context.Context, ids []uuid.UUID) ([]domain.Asset, error)
+
+	// GetForShare is Get that also locks the asset row against changes until the transaction carried by ctx
+	// ends, so a check made on the asset (e.g. not archived) still holds at commit.
+	GetForShare(ctx context.Context, id uuid.UUID) (*domain.Asset, error)
+
+	// GetManyForShare is GetMany with the same row locks as GetForShare.
+	GetManyForShare(ctx context.Context, ids []uuid.UUID) ([]domain.Asset, error)
+
+	// Create inserts a new asset and sets its generated ID.
+	Create(ctx context.Context, a *domain.Asset) error
+
+	// Update persists changes to an asset if its stored version still matches
+	// (domain.ErrAssetVersionMismatch otherwise), then bumps the version.
 	Update(ctx context.Context, a *domain.Asset) error
+
+	// Delete removes an asset and its favourites. Missing should return domain.ErrAssetNotFound.
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// ListKeyset returns non-archived assets matching filter, ordered by created_at,id,
+	// and an opaque next cursor.
+	ListKeyset(ctx context.Context, filter AssetFilter, limit int, after string) ([]domain.Asset, *string, error)
 }
@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/google/uuid"
+)
+
+// AssetRevisionRepository stores and queries asset description revisions.
+type AssetRevisionRepository interface {
+	// Create inserts a revision and sets its generated ID.
+	Create(ctx context.Context, r *domain.AssetRevision) error
+
+	// Get returns a revision of the asset or domain.ErrRevisionNotFound.
+	Get(ctx context.Context, assetID, revisionID uuid.UUID) (*domain.AssetRevision, error)
+
+	// ListByAssetKeyset returns an asset's revisions, newest first
+	// (created_at,id descending), and an opaque next cursor.
+	ListByAssetKeyset(ctx context.Context, assetID uuid.UUID, limit int, after string) ([]domain.AssetRevision, *string, error)
+}
@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+)
+
+// AuditRepository reads the audit log. Entries are written by the persistence layer itself,
+// alongside the changes they record.
+type AuditRepository interface {
+	// ListKeyset returns entries matching filter newest first using keyset pagination.
+	// A malformed cursor returns domain.ErrBadCursor.
+	ListKeyset(ctx context.Context, filter domain.AuditFilter, limit int, after string) ([]domain.AuditEntry, *string, error)
+}
@@ -0,0 +1,36 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/google/uuid"
+)
+
+// CollectionRepository stores collections and their favourite memberships.
+type CollectionRepository interface {
+	// Create inserts a collection and sets its generated ID.
+	// A duplicate name for the same user should return domain.ErrCollectionAlreadyExists.
+	Create(ctx context.Context, c *domain.Collection) error
+
+	// Get returns a collection owned by userID or domain.ErrCollectionNotFound.
+	Get(ctx context.Context, userID, collectionID uuid.UUID) (*domain.Collection, error)
+
+	// ListByUser returns all collections of a user, oldest first.
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]domain.Collection, error)
+
+	// Update persists mutable collection fields (the name).
+	// A duplicate name should return domain.ErrCollectionAlreadyExists.
+	Update(ctx context.Context, c *domain.Collection) error
+
+	// Delete removes a collection owned by userID. Its favourites are kept.
+	// Missing should return domain.ErrCollectionNotFound.
+	Delete(ctx context.Context, userID, collectionID uuid.UUID) error
+
+	// AddFavourite puts a favourite in a collection. Adding twice is a no-op.
+	AddFavourite(ctx context.Context, collectionID, favouriteID uuid.UUID) error
+
+	// RemoveFavourite takes a favourite out of a collection.
+	// A favourite not in the collection should return domain.ErrFavouriteNotFound.
+	RemoveFavourite(ctx context.Context, collectionID, favouriteID uuid.UUID) error
+}
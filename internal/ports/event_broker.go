@@ -0,0 +1,32 @@
+package ports
+
+import (
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/google/uuid"
+)
+
+// EventPublisher delivers live favourite events. Publishing never blocks on slow consumers.
+type EventPublisher interface {
+	// Publish assigns the event its ID and hands it to the current subscribers of its user.
+	Publish(event domain.FavouriteEvent)
+}
+
+// EventSubscription is a user's live feed of favourite events.
+type EventSubscription struct {
+	// Missed holds the retained events published after the requested last event ID.
+	Missed []domain.FavouriteEvent
+	// Gap is true when events after the requested last event ID are no longer retained.
+	Gap bool
+	// Events is closed when the subscriber falls too far behind or the broker shuts down.
+	Events <-chan domain.FavouriteEvent
+	// Cancel ends the subscription; it is safe to call more than once.
+	Cancel func()
+}
+
+// EventBroker publishes favourite events and lets clients follow them.
+type EventBroker interface {
+	EventPublisher
+	// Subscribe follows the user's events, replaying those after lastEventID (0 for none).
+	// After shutdown it should return domain.ErrEventsClosed.
+	Subscribe(userID uuid.UUID, lastEventID uint64) (EventSubscription, error)
+}
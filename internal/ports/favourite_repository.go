@@ -2,23 +2,80 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
 	"github.com/google/uuid"
 )
 
+// FavouriteFilter narrows down a user's favourites listing. Zero values mean "no filter".
+type FavouriteFilter struct {
+	CollectionID *uuid.UUID           // only favourites in this collection
+	AssetType    domain.AssetType     // only assets of this type
+	Sort         domain.FavouriteSort // ordering; empty means domain.SortCreatedAsc
+}
+
+// PageCursors are the opaque cursors around a keyset page. Nil means there is no such page.
+type PageCursors struct {
+	NextAfter  *string // pass as after to get the next page
+	PrevBefore *string // pass as before to get the previous page
+}
+
 // FavouriteRepository stores and queries favourites.
 type FavouriteRepository interface {
-	// Create inserts a favourite. Duplicate should return domain.ErrFavouriteAlreadyExists.
+	// Create inserts a favourite and sets its generated ID.
+	// Duplicate should return domain.ErrFavouriteAlreadyExists, a missing asset domain.ErrAssetNotFound.
 	Create(ctx context.Context, f *domain.Favourite) error
 
-	// Delete removes a favourite. Missing should return domain.ErrFavouriteNotFound.
+	// Get returns the favourite of (user, asset) or domain.ErrFavouriteNotFound.
+	Get(ctx context.Context, userID, assetID uuid.UUID) (*domain.Favourite, error)
+
+	// GetMany returns the user's favourites among assetIDs in one query; unfavourited assets are absent.
+	GetMany(ctx context.Context, userID uuid.UUID, assetIDs []uuid.UUID) ([]domain.Favourite, error)
+
+	// Update persists mutable favourite fields (the note).
+	// Missing should return domain.ErrFavouriteNotFound.
+	Update(ctx context.Context, f *domain.Favourite) error
+
+	// CreateMany inserts several favourites and sets the generated IDs of the created ones.
+	// A duplicate or a missing asset skips only its own row: the returned outcomes, one per favourite,
+	// are domain.FavouriteCreated, domain.FavouriteAlreadyExists or domain.FavouriteAssetNotFound.
+	CreateMany(ctx context.Context, favs []domain.Favourite) ([]domain.FavouriteOutcome, error)
+
+	// DeleteMany removes the user's favourites of assetIDs; assets that are not favourited are ignored.
+	DeleteMany(ctx context.Context, userID uuid.UUID, assetIDs []uuid.UUID) error
+
+	// ListChanges returns the user's favourite additions and removals after the position in token
+	// ("" starts from the beginning), oldest first, the token to resume from, and whether more changes
+	// are available right away. An expired token should return domain.ErrSyncTokenExpired,
+	// an invalid one domain.ErrBadCursor.
+	ListChanges(ctx context.Context, userID uuid.UUID, token string, limit int) ([]domain.FavouriteChange, string, bool, error)
+
+	// PruneTombstones deletes the removal records older than before and returns how many were deleted.
+	PruneTombstones(ctx context.Context, before time.Time) (int, error)
+
+	// Delete removes a favourite and records a tombstone for the change feed.
+	// Missing should return domain.ErrFavouriteNotFound.
 	Delete(ctx context.Context, userID, assetID uuid.UUID) error
 
-	// Exists checks whether (user, asset) is already favourited.
-	Exists(ctx context.Context, userID, assetID uuid.UUID) (bool, error)
+	// ListAssetsFavouritedByUserKeyset returns non-archived assets favourited by a user
+	// and matching filter, together with their favourite, in filter.Sort order.
+	// At most one of after / before is set: after pages forward, before pages backward.
+	ListAssetsFavouritedByUserKeyset(
+		ctx context.Context, userID uuid.UUID, filter FavouriteFilter, limit int, after, before string,
+	) ([]domain.FavouritedAsset, PageCursors, error)
+
+	// CountAssetsFavouritedByUser counts the favourites ListAssetsFavouritedByUserKeyset pages through.
+	CountAssetsFavouritedByUser(ctx context.Context, userID uuid.UUID, filter FavouriteFilter) (int, error)
+
+	// ListMostFavourited ranks non-archived assets by favourite count (ties by asset id), counting only
+	// favourites created at or after since when it is set. It pages with limit/offset and reports
+	// whether more assets follow.
+	ListMostFavourited(ctx context.Context, since *time.Time, limit, offset int) ([]domain.PopularAsset, bool, error)
 
-	// ListAssetsFavouritedByUserKeyset returns assets favourited by a user,
-	// ordered by favourite.created_at,id, and an opaque next cursor.
-	ListAssetsFavouritedByUserKeyset(ctx context.Context, userID uuid.UUID, limit int, after string) ([]domain.Asset, *string, error)
+	// ListFeed returns up to limit additions and removals of all users' favourites after the position,
+	// oldest first, and the position to resume from. Like ListChanges it stops before transactions
+	// still in flight, so following it from the zero position replays every favourite that exists and
+	// every retained removal, and no change commits behind a position already returned.
+	ListFeed(ctx context.Context, after domain.FeedPosition, limit int) ([]domain.FavouriteDelta, domain.FeedPosition, error)
 }
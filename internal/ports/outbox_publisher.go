@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+)
+
+// OutboxPublisher is a destination the outbox relay hands committed domain events to.
+type OutboxPublisher interface {
+	// Name identifies the publisher in logs and in the events it accepted, so it must be unique and stable.
+	Name() string
+
+	// Publish hands the event on. An error makes the relay retry the event later with the publishers
+	// that have not accepted it yet. Delivery is at least once: an event may be published again when
+	// the relay stops between publishing and recording it.
+	Publish(ctx context.Context, event domain.OutboxEvent) error
+}
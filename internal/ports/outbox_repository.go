@@ -0,0 +1,32 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/google/uuid"
+)
+
+// OutboxRepository stores domain events until the relay has published them.
+type OutboxRepository interface {
+	// Append records events and sets their generated IDs. Called with a UnitOfWork context,
+	// the events commit or roll back with the change that raised them.
+	Append(ctx context.Context, events []domain.OutboxEvent) error
+
+	// ClaimPending returns up to limit unpublished events available at now, oldest first, and
+	// pushes their availability lease into the future so that concurrent relays skip them meanwhile.
+	ClaimPending(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]domain.OutboxEvent, error)
+
+	// MarkPublished records that the event was handed to every publisher.
+	MarkPublished(ctx context.Context, id uuid.UUID, at time.Time) error
+
+	// RecordFailure persists the attempts, last error, publishers reached and next availability of an event.
+	RecordFailure(ctx context.Context, e *domain.OutboxEvent) error
+
+	// Backlog returns how many events are unpublished and when the oldest of them was created (nil when none).
+	Backlog(ctx context.Context) (int, *time.Time, error)
+
+	// PrunePublished deletes events published before before and returns how many were deleted.
+	PrunePublished(ctx context.Context, before time.Time) (int, error)
+}
@@ -0,0 +1,10 @@
+package ports
+
+import "context"
+
+// UnitOfWork runs a multi-repository use-case atomically.
+type UnitOfWork interface {
+	// WithinTx runs fn in a transaction, committing when fn returns nil and rolling back otherwise.
+	// Repository calls made with the ctx handed to fn join the transaction; nested calls reuse it.
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
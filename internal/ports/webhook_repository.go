@@ -0,0 +1,45 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+	"github.com/google/uuid"
+)
+
+// WebhookRepository stores webhook subscriptions and their deliveries.
+type WebhookRepository interface {
+	// CreateSubscription inserts a subscription and sets its generated ID.
+	CreateSubscription(ctx context.Context, s *domain.WebhookSubscription) error
+
+	// GetSubscription returns the subscription or domain.ErrWebhookNotFound.
+	GetSubscription(ctx context.Context, id uuid.UUID) (*domain.WebhookSubscription, error)
+
+	// ListSubscriptions returns all subscriptions, oldest first.
+	ListSubscriptions(ctx context.Context) ([]domain.WebhookSubscription, error)
+
+	// ListActiveSubscriptions returns the subscriptions that take new deliveries.
+	ListActiveSubscriptions(ctx context.Context) ([]domain.WebhookSubscription, error)
+
+	// UpdateSubscription persists the URL, event types and active flag.
+	// Missing should return domain.ErrWebhookNotFound.
+	UpdateSubscription(ctx context.Context, s *domain.WebhookSubscription) error
+
+	// DeleteSubscription removes a subscription together with its deliveries.
+	// Missing should return domain.ErrWebhookNotFound.
+	DeleteSubscription(ctx context.Context, id uuid.UUID) error
+
+	// CreateDeliveries queues deliveries and sets their generated IDs.
+	CreateDeliveries(ctx context.Context, deliveries []domain.WebhookDelivery) error
+
+	// ClaimDueDeliveries returns up to limit pending deliveries due at now, oldest first, and pushes
+	// their next attempt lease into the future so that concurrent workers skip them meanwhile.
+	ClaimDueDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]domain.WebhookDelivery, error)
+
+	// UpdateDelivery persists the outcome of a delivery attempt.
+	UpdateDelivery(ctx context.Context, d *domain.WebhookDelivery) error
+
+	// ListDeliveriesKeyset returns a subscription's deliveries, newest first, using keyset pagination.
+	ListDeliveriesKeyset(ctx context.Context, subscriptionID uuid.UUID, limit int, after string) ([]domain.WebhookDelivery, *string, error)
+}
@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/SokratisChaimanas/platform-go-challenge/internal/domain"
+)
+
+// WebhookSender makes delivery attempts.
+type WebhookSender interface {
+	// Send posts the delivery payload to the subscription URL, signed with its secret, and returns
+	// the response status code. An error means no response was received.
+	Send(ctx context.Context, s domain.WebhookSubscription, d domain.WebhookDelivery) (int, error)
+
+	// CheckURL returns an error when deliveries to rawURL would be refused, e.g. because its host
+	// is or resolves to a private, loopback or link-local address.
+	CheckURL(ctx context.Context, rawURL string) error
+}